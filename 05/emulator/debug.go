@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"hack-emulator/emu"
+)
+
+func debugCommand(args []string) int {
+	flags := flag.NewFlagSet("debug", flag.ExitOnError)
+	symFile := flags.String("sym", "", "symbol file from the assembler's --sym flag (default: the .sym file next to the program)")
+	files := parseArgs(flags, args)
+	if len(files) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: emulator debug [--sym file.sym] file.hack")
+		return 2
+	}
+	m, syms, err := load(files[0], *symFile)
+	if err != nil {
+		return fail(err)
+	}
+	d := emu.NewDebugger(m, syms, os.Stdout)
+
+	// Ctrl-C stops a running continue or next instead of the debugger
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	go func() {
+		for range interrupts {
+			d.Interrupt()
+		}
+	}()
+
+	if err := d.Run(os.Stdin); err != nil {
+		return fail(err)
+	}
+	return 0
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"hack-emulator/emu"
+)
+
+func diffCommand(args []string) int {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	symFile := flags.String("sym", "", "symbol file for labels and variable names")
+	max := flags.Int("max", 20, "list at most `N` changed addresses in each region (0 for all)")
+	files := parseArgs(flags, args)
+	if len(files) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: emulator diff [--sym file.sym] [--max N] old.snap new.snap")
+		return 2
+	}
+	syms := emu.NewSymbols()
+	if *symFile != "" {
+		if err := syms.ReadSymbols(*symFile); err != nil {
+			return fail(err)
+		}
+	}
+	old, err := emu.LoadSnapshot(files[0])
+	if err != nil {
+		return fail(err)
+	}
+	new, err := emu.LoadSnapshot(files[1])
+	if err != nil {
+		return fail(err)
+	}
+	d := emu.DiffSnapshots(old, new)
+	if err := d.Write(os.Stdout, syms, *max); err != nil {
+		return fail(err)
+	}
+	if d.Empty() {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,322 @@
+package emu
+
+// The fast core runs ROM decoded into ops, one per word, so the dispatch loop does not take
+// instructions apart again each time it runs them. Step stays the reference: it reads ROM
+// as it is, and the tests check the two against each other.
+
+// op is a decoded instruction
+type op struct {
+	kind  uint8  // opA, or the ALU function
+	dest  uint8  // destA, destD, destM
+	jump  uint8  // the j1 j2 j3 bits
+	flags uint8  // opUseM, opCheck, opCheckAny, opStop
+	ctrl  uint16 // the ALU control bits, for opALU
+	value uint16 // the value of an A-instruction
+	haltA uint16 // the value A needs for Halted to hold, with opCheck
+}
+
+// Op kinds: the computations the assembler has mnemonics for, and the ALU for the rest
+const (
+	opA uint8 = iota
+	opZero
+	opOne
+	opMinusOne
+	opD
+	opY // A, or M with opUseM
+	opNotD
+	opNotY
+	opNegD
+	opNegY
+	opDPlus1
+	opYPlus1
+	opDMinus1
+	opYMinus1
+	opDPlusY
+	opDMinusY
+	opYMinusD
+	opDAndY
+	opDOrY
+	opALU
+)
+
+// The dest bits of an op, as in the instruction
+const (
+	destM = 1 << iota
+	destD
+	destA
+)
+
+const (
+	opUseM     = 1 << iota // the ALU's y input is M, not A
+	opCheck                // the op may start a loop that Halted detects, if A is haltA
+	opCheckAny             // the same, whatever A is
+	opStop                 // RunToHalt stops here
+)
+
+// compOps maps the six ALU control bits of the computations with mnemonics to their op
+var compOps = map[uint16]uint8{
+	0x2A: opZero, 0x3F: opOne, 0x3A: opMinusOne,
+	0x0C: opD, 0x30: opY, 0x0D: opNotD, 0x31: opNotY, 0x0F: opNegD, 0x33: opNegY,
+	0x1F: opDPlus1, 0x37: opYPlus1, 0x0E: opDMinus1, 0x32: opYMinus1,
+	0x02: opDPlusY, 0x13: opDMinusY, 0x07: opYMinusD, 0x00: opDAndY, 0x15: opDOrY,
+}
+
+// code is a program decoded for the fast core. Copies of a machine share it, which is safe
+// because it changes only with ROM, apart from the opStop marks, which RunToHalt sets
+// afresh each time and only it heeds.
+type code struct {
+	ops   [ROMSize]op
+	stops []uint16 // the addresses marked opStop
+}
+
+// ROMChanged tells the machine that ROM was written other than with Load, so the fast core
+// decodes it again
+func (m *Machine) ROMChanged() {
+	m.code = nil
+}
+
+// decoded returns the program decoded, decoding it if ROM has changed
+func (m *Machine) decoded() *code {
+	if m.code == nil {
+		c := &code{}
+		for addr, instr := range m.ROM {
+			o := decode(instr)
+			starts := haltStarts(&m.ROM, uint16(addr))
+			for i, a := range starts {
+				if i == 0 {
+					o.flags |= opCheck
+					o.haltA = a & 0x7FFF
+				} else if a&0x7FFF != o.haltA {
+					o.flags |= opCheckAny
+				}
+			}
+			c.ops[addr] = o
+		}
+		m.code = c
+	}
+	return m.code
+}
+
+// decode decodes one instruction
+func decode(instr uint16) op {
+	if instr&0x8000 == 0 {
+		return op{kind: opA, value: instr}
+	}
+	o := op{dest: uint8(instr>>3) & 7, jump: uint8(instr) & 7, ctrl: instr >> 6 & 0x3F}
+	if instr&0x1000 != 0 {
+		o.flags |= opUseM
+	}
+	kind, ok := compOps[o.ctrl]
+	if !ok {
+		kind = opALU
+	}
+	o.kind = kind
+	return o
+}
+
+// haltStarts returns the values of A with which Halted could hold with the PC at addr.
+// Halted needs the loop to come back with A as it was, so A must be the value of the last
+// A-instruction before addr or, with none, the address the loop jumps to just before addr.
+// Either is within the few instructions before addr. haltStarts tries each of those values
+// of A on every path from addr, and only rules one out if each path writes a register or RAM,
+// or goes elsewhere, before it comes back. So the fast core only needs to call Halted where
+// A has a value given here, which in compiled code is little more than in the halt loops.
+func haltStarts(rom *[ROMSize]uint16, addr uint16) []uint16 {
+	var walk func(pc, a, start uint16, steps int) bool
+	walk = func(pc, a, start uint16, steps int) bool {
+		for ; steps < haltWindow; steps++ {
+			instr := rom[pc]
+			switch {
+			case instr&0x8000 == 0:
+				a = instr
+				pc = (pc + 1) & 0x7FFF
+			case instr&0x0038 != 0:
+				return false
+			case instr&0x7 == 0:
+				pc = (pc + 1) & 0x7FFF
+			case instr&0x7 == 0x7:
+				pc = a & 0x7FFF
+			default:
+				if a&0x7FFF == addr && a == start || walk(a&0x7FFF, a, start, steps+1) {
+					return true
+				}
+				pc = (pc + 1) & 0x7FFF
+			}
+			if pc == addr && a == start {
+				return true
+			}
+		}
+		return false
+	}
+	if instr := rom[addr]; instr&0x8000 != 0 && instr&0x0038 != 0 {
+		return nil
+	}
+	var found []uint16
+	for back := uint16(0); back <= haltWindow; back++ {
+		at := (addr - back) & 0x7FFF
+		starts := []uint16{at, at | 0x8000}
+		if instr := rom[at]; instr&0x8000 == 0 && back > 0 {
+			starts = append(starts, instr)
+		}
+		for _, start := range starts {
+			if walk(addr, start, start, 0) {
+				found = append(found, start)
+			}
+		}
+	}
+	return found
+}
+
+// Halts says where RunToHalt stops, besides the cycle limit
+type Halts struct {
+	Loops bool     // at a loop that Halted detects
+	At    []uint16 // when the PC reaches one of these addresses
+}
+
+// Run executes n instructions
+func (m *Machine) Run(n uint64) {
+	m.run(n, false, false)
+}
+
+// RunToHalt executes instructions until the program halts as h says, or max have run. It
+// checks before each instruction, as a loop calling Step and then Halted would, and reports
+// whether the program halted.
+func (m *Machine) RunToHalt(max uint64, h Halts) bool {
+	c := m.decoded()
+	c.mark(h.At)
+	return m.run(max, h.Loops, len(h.At) > 0)
+}
+
+// mark sets opStop at the addresses given, and clears it from those of the last run
+func (c *code) mark(addrs []uint16) {
+	for _, addr := range c.stops {
+		c.ops[addr&0x7FFF].flags &^= opStop
+	}
+	c.stops = append(c.stops[:0], addrs...)
+	for _, addr := range c.stops {
+		c.ops[addr&0x7FFF].flags |= opStop
+	}
+}
+
+// run is the fast core. It executes up to n instructions and stops before one where Halted
+// holds, with loops set, or at an opStop address, with stops set, and reports whether it did.
+func (m *Machine) run(n uint64, loops, stops bool) bool {
+	c := m.decoded()
+	if n == 0 {
+		return false
+	}
+	ops, ram := &c.ops, &m.RAM
+	a, d, pc := m.A, m.D, m.PC
+	start := m.Cycles
+	end := start + n
+	halted := false
+	cycles := start
+	for ; cycles < end; cycles++ {
+		o := &ops[pc]
+		if o.flags&(opCheck|opStop) != 0 && (loops || stops) {
+			if stops && o.flags&opStop != 0 {
+				halted = true
+				break
+			}
+			if loops && o.flags&opCheck != 0 && (o.flags&opCheckAny != 0 || a&0x7FFF == o.haltA) {
+				m.A, m.D, m.PC = a, d, pc
+				if m.Halted() {
+					halted = true
+					break
+				}
+			}
+		}
+		if o.kind == opA {
+			a = o.value
+			pc = (pc + 1) & 0x7FFF
+			continue
+		}
+
+		y := a
+		if o.flags&opUseM != 0 {
+			y = ram[a&0x7FFF]
+		}
+		var out uint16
+		switch o.kind {
+		case opZero:
+			out = 0
+		case opOne:
+			out = 1
+		case opMinusOne:
+			out = 0xFFFF
+		case opD:
+			out = d
+		case opY:
+			out = y
+		case opNotD:
+			out = ^d
+		case opNotY:
+			out = ^y
+		case opNegD:
+			out = -d
+		case opNegY:
+			out = -y
+		case opDPlus1:
+			out = d + 1
+		case opYPlus1:
+			out = y + 1
+		case opDMinus1:
+			out = d - 1
+		case opYMinus1:
+			out = y - 1
+		case opDPlusY:
+			out = d + y
+		case opDMinusY:
+			out = d - y
+		case opYMinusD:
+			out = y - d
+		case opDAndY:
+			out = d & y
+		case opDOrY:
+			out = d | y
+		default:
+			out = alu(d, y, o.ctrl)
+		}
+
+		next := (pc + 1) & 0x7FFF
+		if o.jump != 0 {
+			var taken bool
+			switch {
+			case int16(out) < 0:
+				taken = o.jump&0x4 != 0
+			case out == 0:
+				taken = o.jump&0x2 != 0
+			default:
+				taken = o.jump&0x1 != 0
+			}
+			if taken {
+				next = a & 0x7FFF
+			}
+		}
+		if o.dest != 0 {
+			if o.dest&destM != 0 {
+				addr := a & 0x7FFF
+				switch {
+				case addr < Screen:
+					ram[addr] = out
+				case addr < KBD:
+					if ram[addr] != out {
+						m.ScreenChanged = cycles + 1
+					}
+					ram[addr] = out
+				case addr > KBD:
+					ram[addr] = out
+				}
+			}
+			if o.dest&destA != 0 {
+				a = out
+			}
+			if o.dest&destD != 0 {
+				d = out
+			}
+		}
+		pc = next
+	}
+	m.A, m.D, m.PC, m.Cycles = a, d, pc, cycles
+	return halted
+}
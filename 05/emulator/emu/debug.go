@@ -0,0 +1,466 @@
+package emu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"hack-assembler/asm"
+)
+
+// Breakpoint stops execution before the instruction at Addr, if Cond holds
+type Breakpoint struct {
+	ID    int
+	Addr  int
+	Where string // as the user wrote it
+	Cond  *Expr  // nil to always stop
+	Hits  int
+}
+
+// Debugger is an interactive debugger for a machine, driven by one command per line
+type Debugger struct {
+	m      *Machine
+	syms   *Symbols
+	out    io.Writer
+	breaks []*Breakpoint
+	nextID int
+	last   string // command repeated by an empty line
+	stop   int32  // set by Interrupt
+}
+
+// debugCommand is a debugger command and its help
+type debugCommand struct {
+	names []string
+	args  string
+	help  string
+	run   func(d *Debugger, args string) error
+}
+
+var debugCommands []debugCommand
+
+func init() {
+	debugCommands = []debugCommand{
+		{[]string{"step", "s"}, "[N]", "execute N instructions (default 1), stopping at a breakpoint", (*Debugger).step},
+		{[]string{"next", "n"}, "[N]", "like step, but run a VM call until it returns", (*Debugger).next},
+		{[]string{"continue", "c"}, "", "run until a breakpoint, the program halts or an interrupt (Ctrl-C)", (*Debugger).cont},
+		{[]string{"break", "b"}, "LOC [if COND]", "stop at LOC: a ROM address, LABEL or LABEL+N", (*Debugger).setBreak},
+		{[]string{"delete", "d"}, "[ID]", "delete a breakpoint, or all of them", (*Debugger).deleteBreak},
+		{[]string{"breaks", "info"}, "", "list the breakpoints", (*Debugger).listBreaks},
+		{[]string{"print", "p"}, "EXPR", "evaluate an expression, such as RAM[SP-1] or D+1", (*Debugger).print},
+		{[]string{"set"}, "TARGET = EXPR", "set A, D, PC, M, RAM[n] or a RAM symbol", (*Debugger).set},
+		{[]string{"regs", "r"}, "", "show the registers and the VM segment pointers", (*Debugger).regs},
+		{[]string{"x"}, "ADDR [N]", "show N RAM cells from ADDR (default 8)", (*Debugger).examine},
+		{[]string{"list", "l"}, "[LOC]", "disassemble around LOC (default PC)", (*Debugger).list},
+		{[]string{"save"}, "FILE", "save RAM, the registers and the cycle count to a snapshot file", (*Debugger).save},
+		{[]string{"restore"}, "FILE", "go back to the state in a snapshot file of this program", (*Debugger).restore},
+		{[]string{"backtrace", "bt"}, "", "show the VM call stack, from the frames saved by call", (*Debugger).backtrace},
+		{[]string{"help", "h"}, "", "show this help", (*Debugger).help},
+	}
+}
+
+// NewDebugger creates a debugger for a machine, writing to out
+func NewDebugger(m *Machine, syms *Symbols, out io.Writer) *Debugger {
+	return &Debugger{m: m, syms: syms, out: out, nextID: 1}
+}
+
+// Interrupt stops a running command at the next instruction. It is safe to call from a
+// signal handler's goroutine.
+func (d *Debugger) Interrupt() {
+	atomic.StoreInt32(&d.stop, 1)
+}
+
+// Run reads commands from in until it ends or the user quits
+func (d *Debugger) Run(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	d.where()
+	for {
+		fmt.Fprint(d.out, "(hack) ")
+		if !scanner.Scan() {
+			fmt.Fprintln(d.out)
+			return scanner.Err()
+		}
+		if d.Exec(scanner.Text()) {
+			return nil
+		}
+	}
+}
+
+// Exec runs one command line and reports whether it was quit. An empty line repeats the
+// last command.
+func (d *Debugger) Exec(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		line = d.last
+	}
+	if line == "" {
+		return false
+	}
+	name, args, _ := strings.Cut(line, " ")
+	args = strings.TrimSpace(args)
+	if name == "quit" || name == "q" {
+		return true
+	}
+	for _, cmd := range debugCommands {
+		for _, n := range cmd.names {
+			if n == name {
+				d.last = line
+				if err := cmd.run(d, args); err != nil {
+					fmt.Fprintln(d.out, err)
+				}
+				return false
+			}
+		}
+	}
+	fmt.Fprintf(d.out, "unknown command %q; try help\n", name)
+	return false
+}
+
+// count reads an optional repeat count
+func count(args string, def int) (int, error) {
+	if args == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(args)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a positive count", args)
+	}
+	return n, nil
+}
+
+func (d *Debugger) step(args string) error {
+	n, err := count(args, 1)
+	if err != nil {
+		return err
+	}
+	// as with cont, the first instruction runs even from a breakpoint
+	end := d.m.Cycles + uint64(n)
+	d.m.Step()
+	d.runUntil(func(m *Machine) bool { return m.Cycles >= end })
+	d.where()
+	return nil
+}
+
+// next steps over the instructions, running each VM call until it returns. A call is the
+// 08 translator's "@f 0;JMP" followed by its return label (f$retN), and it has returned
+// when the PC reaches that label with the stack pointer below where it was at the jump.
+func (d *Debugger) next(args string) error {
+	n, err := count(args, 1)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		after := int(d.m.PC) + 1
+		name, off, _ := d.syms.LabelAt(after)
+		if off != 0 || !strings.Contains(name, "$ret") || !d.jumps() {
+			d.m.Step()
+			continue
+		}
+		sp := d.m.RAM[0]
+		d.m.Step()
+		if stopped := d.runUntil(func(m *Machine) bool { return int(m.PC) == after && m.RAM[0] < sp }); stopped {
+			break
+		}
+	}
+	d.where()
+	return nil
+}
+
+// jumps reports whether the instruction at PC is an unconditional jump
+func (d *Debugger) jumps() bool {
+	instr := d.m.ROM[d.m.PC]
+	return instr&0x8000 != 0 && instr&0x7 == 0x7
+}
+
+func (d *Debugger) cont(args string) error {
+	d.m.Step()
+	d.runUntil(func(m *Machine) bool { return false })
+	d.where()
+	return nil
+}
+
+// runUntil runs until done holds or a breakpoint, a halt or an interrupt stops it first, and
+// reports whether it was stopped
+func (d *Debugger) runUntil(done func(m *Machine) bool) bool {
+	atomic.StoreInt32(&d.stop, 0)
+	for {
+		if done(d.m) {
+			return false
+		}
+		if b := d.breakAt(); b != nil {
+			b.Hits++
+			fmt.Fprintf(d.out, "breakpoint %d at %s\n", b.ID, b.Where)
+			return true
+		}
+		if d.m.Halted() {
+			fmt.Fprintln(d.out, "the program has halted")
+			return true
+		}
+		if d.m.Cycles&0xFFF == 0 && atomic.LoadInt32(&d.stop) != 0 {
+			fmt.Fprintln(d.out, "interrupted")
+			return true
+		}
+		d.m.Step()
+	}
+}
+
+// breakAt returns the breakpoint that stops the machine where it is, if any
+func (d *Debugger) breakAt() *Breakpoint {
+	for _, b := range d.breaks {
+		if b.Addr == int(d.m.PC) && (b.Cond == nil || b.Cond.True(d.m)) {
+			return b
+		}
+	}
+	return nil
+}
+
+// location reads a ROM address written as a number, LABEL or LABEL+N
+func (d *Debugger) location(s string) (int, error) {
+	if n, err := strconv.ParseUint(s, 10, 15); err == nil {
+		return int(n), nil
+	}
+	name, off, found := strings.Cut(s, "+")
+	addr, ok := d.syms.LabelAddress(strings.TrimSpace(name))
+	if !ok {
+		return 0, fmt.Errorf("no label %s (labels come from the .sym file the assembler writes with --sym)", name)
+	}
+	if found {
+		n, err := strconv.Atoi(strings.TrimSpace(off))
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("bad offset in %s", s)
+		}
+		addr += n
+	}
+	if addr >= ROMSize {
+		return 0, fmt.Errorf("%s is past the end of ROM", s)
+	}
+	return addr, nil
+}
+
+func (d *Debugger) setBreak(args string) error {
+	where, cond, hasCond := strings.Cut(args, " if ")
+	where = strings.TrimSpace(where)
+	if where == "" {
+		return fmt.Errorf("usage: break LOC [if COND]")
+	}
+	addr, err := d.location(where)
+	if err != nil {
+		return err
+	}
+	b := &Breakpoint{ID: d.nextID, Addr: addr, Where: d.syms.Locate(addr)}
+	if hasCond {
+		if b.Cond, err = ParseExpr(strings.TrimSpace(cond), d.syms); err != nil {
+			return fmt.Errorf("condition %q: %v", cond, err)
+		}
+	}
+	d.nextID++
+	d.breaks = append(d.breaks, b)
+	fmt.Fprintf(d.out, "breakpoint %d at %s\n", b.ID, b.Where)
+	return nil
+}
+
+func (d *Debugger) deleteBreak(args string) error {
+	if args == "" {
+		d.breaks = nil
+		return nil
+	}
+	id, err := strconv.Atoi(args)
+	if err != nil {
+		return fmt.Errorf("usage: delete [ID]")
+	}
+	for i, b := range d.breaks {
+		if b.ID == id {
+			d.breaks = append(d.breaks[:i], d.breaks[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("no breakpoint %d", id)
+}
+
+func (d *Debugger) listBreaks(args string) error {
+	if len(d.breaks) == 0 {
+		fmt.Fprintln(d.out, "no breakpoints")
+	}
+	for _, b := range d.breaks {
+		fmt.Fprintf(d.out, "%d\t%s", b.ID, b.Where)
+		if b.Cond != nil {
+			fmt.Fprintf(d.out, " if %s", b.Cond.Text)
+		}
+		fmt.Fprintf(d.out, "\thit %d times\n", b.Hits)
+	}
+	return nil
+}
+
+func (d *Debugger) print(args string) error {
+	e, err := ParseExpr(args, d.syms)
+	if err != nil {
+		return err
+	}
+	v := e.Value(d.m)
+	fmt.Fprintf(d.out, "%s = %d (0x%04X)\n", args, int16(v), v)
+	return nil
+}
+
+func (d *Debugger) set(args string) error {
+	a, err := ParseAssignment(args, d.syms)
+	if err != nil {
+		return err
+	}
+	a.Apply(d.m)
+	if a.Target == "PC" {
+		d.where()
+	}
+	return nil
+}
+
+func (d *Debugger) regs(args string) error {
+	m := d.m
+	fmt.Fprintf(d.out, "PC=%d A=%d D=%d M=%d cycles=%d\n", m.PC, int16(m.A), int16(m.D), int16(m.RAM[m.A&0x7FFF]), m.Cycles)
+	fmt.Fprintf(d.out, "SP=%d LCL=%d ARG=%d THIS=%d THAT=%d\n", m.RAM[0], m.RAM[1], m.RAM[2], m.RAM[3], m.RAM[4])
+	return nil
+}
+
+func (d *Debugger) examine(args string) error {
+	fields := strings.Fields(args)
+	if len(fields) == 0 || len(fields) > 2 {
+		return fmt.Errorf("usage: x ADDR [N]")
+	}
+	e, err := ParseExpr(fields[0], d.syms)
+	if err != nil {
+		return err
+	}
+	n := 8
+	if len(fields) == 2 {
+		if n, err = count(fields[1], 8); err != nil {
+			return err
+		}
+	}
+	start := int(e.Value(d.m) & 0x7FFF)
+	for addr := start; addr < start+n && addr < RAMSize; addr++ {
+		fmt.Fprintf(d.out, "RAM[%d]", addr)
+		if name := d.syms.RAMName(addr); name != "" {
+			fmt.Fprintf(d.out, " %s", name)
+		}
+		fmt.Fprintf(d.out, " = %d\n", int16(d.m.RAM[addr]))
+	}
+	return nil
+}
+
+// list disassembles five instructions either side of a location, marking the PC with =>
+// and breakpoints with *
+func (d *Debugger) list(args string) error {
+	center := int(d.m.PC)
+	if args != "" {
+		var err error
+		if center, err = d.location(args); err != nil {
+			return err
+		}
+	}
+	from, to := center-5, center+5
+	if from < 0 {
+		from = 0
+	}
+	if to >= ROMSize {
+		to = ROMSize - 1
+	}
+	for addr := from; addr <= to; addr++ {
+		if name, off, ok := d.syms.LabelAt(addr); ok && off == 0 {
+			fmt.Fprintf(d.out, "(%s)\n", name)
+		}
+		mark := "  "
+		if addr == int(d.m.PC) {
+			mark = "=>"
+		}
+		b := " "
+		for _, bp := range d.breaks {
+			if bp.Addr == addr {
+				b = "*"
+			}
+		}
+		fmt.Fprintf(d.out, "%s%s %5d  %s\n", mark, b, addr, disassemble(d.m.ROM[addr]))
+	}
+	return nil
+}
+
+func (d *Debugger) save(args string) error {
+	if args == "" {
+		return fmt.Errorf("usage: save FILE")
+	}
+	if err := d.m.Snapshot().Save(args); err != nil {
+		return err
+	}
+	fmt.Fprintf(d.out, "saved cycle %d to %s\n", d.m.Cycles, args)
+	return nil
+}
+
+func (d *Debugger) restore(args string) error {
+	if args == "" {
+		return fmt.Errorf("usage: restore FILE")
+	}
+	s, err := LoadSnapshot(args)
+	if err != nil {
+		return err
+	}
+	if err := d.m.Restore(s); err != nil {
+		return fmt.Errorf("%s: %v", args, err)
+	}
+	d.where()
+	return nil
+}
+
+// backtrace follows the frames that the 08 translator's call saves below LCL: the return
+// address at LCL-5 and the caller's LCL at LCL-4
+func (d *Debugger) backtrace(args string) error {
+	pc, lcl := int(d.m.PC), int(d.m.RAM[1])
+	for depth := 0; depth < 100; depth++ {
+		fn, ok := d.syms.FunctionAt(pc)
+		if !ok {
+			fn = "?"
+		}
+		fmt.Fprintf(d.out, "#%d %s at %s\n", depth, fn, d.syms.Locate(pc))
+		if lcl < 5 || lcl >= RAMSize {
+			return nil
+		}
+		ret := int(d.m.RAM[lcl-5])
+		if name, off, ok := d.syms.LabelAt(ret); !ok || off != 0 || !strings.Contains(name, "$ret") {
+			return nil
+		}
+		pc, lcl = ret, int(d.m.RAM[lcl-4])
+	}
+	return nil
+}
+
+func (d *Debugger) help(args string) error {
+	names := []string{}
+	byName := map[string]debugCommand{}
+	for _, cmd := range debugCommands {
+		names = append(names, cmd.names[0])
+		byName[cmd.names[0]] = cmd
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cmd := byName[name]
+		fmt.Fprintf(d.out, "  %-22s %s\n", strings.Join(cmd.names, ", ")+" "+cmd.args, cmd.help)
+	}
+	fmt.Fprintf(d.out, "  %-22s %s\n", "quit, q", "leave the debugger")
+	fmt.Fprintln(d.out, "An empty line repeats the last command.")
+	return nil
+}
+
+// where shows the instruction about to run
+func (d *Debugger) where() {
+	pc := int(d.m.PC)
+	fmt.Fprintf(d.out, "=> %s: %s\n", d.syms.Locate(pc), disassemble(d.m.ROM[pc]))
+}
+
+// disassemble decodes an instruction, or shows a word that is not one in binary
+func disassemble(word uint16) string {
+	text, err := asm.Disassemble(word)
+	if err != nil {
+		return fmt.Sprintf("%016b", word)
+	}
+	return text
+}
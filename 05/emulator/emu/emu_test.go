@@ -0,0 +1,1028 @@
+package emu
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"hack-assembler/asm"
+)
+
+// assemble loads a program from assembly source
+func assemble(t testing.TB, src string) (*Machine, *Symbols) {
+	t.Helper()
+	prog := asm.Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", prog.Diags)
+	}
+	m := New()
+	if err := m.Load(prog.Code); err != nil {
+		t.Fatal(err)
+	}
+	syms := NewSymbols()
+	for _, sym := range prog.Symbols.Symbols() {
+		if sym.Kind != asm.Predefined {
+			syms.AddEntry(sym)
+		}
+	}
+	return m, syms
+}
+
+// shared are the programs the tests take from the projects they belong to, rather than
+// keeping copies in testdata
+var shared = map[string]string{
+	"Mult.asm": filepath.Join("..", "..", "..", "04", "mult", "Mult.asm"),
+	"Fill.asm": filepath.Join("..", "..", "..", "04", "fill", "Fill.asm"),
+	"Rect.asm": filepath.Join("..", "..", "..", "06", "assembler", "asm", "testdata", "Rect.asm"),
+}
+
+// assembleFile assembles one of the shared programs or a program in testdata
+func assembleFile(t testing.TB, name string) (*Machine, *Symbols) {
+	t.Helper()
+	path, ok := shared[name]
+	if !ok {
+		path = filepath.Join("testdata", name)
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return assemble(t, string(src))
+}
+
+func expr(t *testing.T, text string, syms *Symbols) *Expr {
+	t.Helper()
+	e, err := ParseExpr(text, syms)
+	if err != nil {
+		t.Fatalf("%s: %v", text, err)
+	}
+	return e
+}
+
+func TestMult(t *testing.T) {
+	m, syms := assembleFile(t, "Mult.asm")
+	end := expr(t, "PC == END", syms)
+	for _, test := range [][2]uint16{{0, 0}, {1, 0}, {0, 7}, {3, 5}, {5, 3}, {181, 181}, {300, 300}} {
+		m.Reset()
+		m.RAM[0], m.RAM[1], m.RAM[2] = test[0], test[1], 1234
+		if !m.RunUntil(end.True, 1000000) {
+			t.Fatalf("%d*%d did not reach END", test[0], test[1])
+		}
+		if want := test[0] * test[1]; m.RAM[2] != want {
+			t.Errorf("%d*%d: R2 = %d, want %d", test[0], test[1], m.RAM[2], want)
+		}
+	}
+}
+
+func TestFill(t *testing.T) {
+	m, _ := assembleFile(t, "Fill.asm")
+	screen := func() (black, white int) {
+		for _, w := range m.RAM[Screen : Screen+ScreenWords] {
+			switch w {
+			case 0xFFFF:
+				black++
+			case 0:
+				white++
+			}
+		}
+		return
+	}
+	m.SetKey('A')
+	m.Run(200000)
+	if black, _ := screen(); black != ScreenWords {
+		t.Errorf("with a key held, %d of %d screen words are black", black, ScreenWords)
+	}
+	m.SetKey(0)
+	m.Run(200000)
+	if _, white := screen(); white != ScreenWords {
+		t.Errorf("with no key, %d of %d screen words are white", white, ScreenWords)
+	}
+}
+
+// TestALU checks every comp mnemonic of the standard profile against Go arithmetic
+func TestALU(t *testing.T) {
+	comps := map[string]func(a, d, m uint16) uint16{
+		"0":   func(a, d, m uint16) uint16 { return 0 },
+		"1":   func(a, d, m uint16) uint16 { return 1 },
+		"-1":  func(a, d, m uint16) uint16 { return 0xFFFF },
+		"D":   func(a, d, m uint16) uint16 { return d },
+		"A":   func(a, d, m uint16) uint16 { return a },
+		"M":   func(a, d, m uint16) uint16 { return m },
+		"!D":  func(a, d, m uint16) uint16 { return ^d },
+		"!A":  func(a, d, m uint16) uint16 { return ^a },
+		"!M":  func(a, d, m uint16) uint16 { return ^m },
+		"-D":  func(a, d, m uint16) uint16 { return -d },
+		"-A":  func(a, d, m uint16) uint16 { return -a },
+		"-M":  func(a, d, m uint16) uint16 { return -m },
+		"D+1": func(a, d, m uint16) uint16 { return d + 1 },
+		"A+1": func(a, d, m uint16) uint16 { return a + 1 },
+		"M+1": func(a, d, m uint16) uint16 { return m + 1 },
+		"D-1": func(a, d, m uint16) uint16 { return d - 1 },
+		"A-1": func(a, d, m uint16) uint16 { return a - 1 },
+		"M-1": func(a, d, m uint16) uint16 { return m - 1 },
+		"D+A": func(a, d, m uint16) uint16 { return d + a },
+		"D+M": func(a, d, m uint16) uint16 { return d + m },
+		"D-A": func(a, d, m uint16) uint16 { return d - a },
+		"D-M": func(a, d, m uint16) uint16 { return d - m },
+		"A-D": func(a, d, m uint16) uint16 { return a - d },
+		"M-D": func(a, d, m uint16) uint16 { return m - d },
+		"D&A": func(a, d, m uint16) uint16 { return d & a },
+		"D&M": func(a, d, m uint16) uint16 { return d & m },
+		"D|A": func(a, d, m uint16) uint16 { return d | a },
+		"D|M": func(a, d, m uint16) uint16 { return d | m },
+	}
+	isa := asm.Standard()
+	rng := rand.New(rand.NewSource(1))
+	m := New()
+	for comp, want := range comps {
+		bits, ok := isa.Comp[comp]
+		if !ok {
+			t.Fatalf("no encoding for %s", comp)
+		}
+		m.ROM[0] = bits<<6 | isa.Dest["D"]<<3
+		for i := 0; i < 100; i++ {
+			a, d, mem := uint16(rng.Intn(32768)), uint16(rng.Intn(65536)), uint16(rng.Intn(65536))
+			m.A, m.D, m.PC, m.RAM[a] = a, d, 0, mem
+			m.Step()
+			if got := want(a, d, mem); m.D != got {
+				t.Fatalf("D=%s with A=%d D=%d M=%d: got %d, want %d", comp, a, d, mem, m.D, got)
+			}
+		}
+	}
+}
+
+func TestJumps(t *testing.T) {
+	isa := asm.Standard()
+	tests := map[string][3]bool{ // taken for D < 0, D = 0, D > 0
+		"JGT": {false, false, true},
+		"JEQ": {false, true, false},
+		"JGE": {false, true, true},
+		"JLT": {true, false, false},
+		"JNE": {true, false, true},
+		"JLE": {true, true, false},
+		"JMP": {true, true, true},
+	}
+	m := New()
+	for jump, want := range tests {
+		m.ROM[5] = isa.Comp["D"]<<6 | isa.Jump[jump]
+		for i, d := range []uint16{0x8000, 0, 1} {
+			m.A, m.D, m.PC = 100, d, 5
+			m.Step()
+			if taken := m.PC == 100; taken != want[i] {
+				t.Errorf("D;%s with D=%d: taken = %v, want %v", jump, int16(d), taken, want[i])
+			}
+		}
+	}
+}
+
+// TestSemantics covers the corners of the Hack CPU: writes and jumps use A from before the
+// instruction, addresses are 15 bits, and the keyboard register is read-only
+func TestSemantics(t *testing.T) {
+	m, _ := assemble(t, "@100\nAM=M+1\n@7\nA=A+1;JMP")
+	m.RAM[100] = 41
+	m.Run(2)
+	if m.RAM[100] != 42 || m.A != 42 {
+		t.Errorf("AM=M+1: RAM[100] = %d, A = %d; want 42 and 42", m.RAM[100], m.A)
+	}
+	m.Run(2)
+	if m.PC != 7 || m.A != 8 {
+		t.Errorf("A=A+1;JMP: PC = %d, A = %d; want the old A (7) and 8", m.PC, m.A)
+	}
+
+	m, _ = assemble(t, "@KBD\nM=1\nD=M\n@32767\nD=D+A\n@0\nM=D\n@32767\nD=A+1\n@5\nA=D+A\nM=-1")
+	m.SetKey('Q')
+	m.Run(12)
+	if m.RAM[KBD] != 'Q' || m.RAM[0] != 'Q'+32767 {
+		t.Errorf("writing KBD: KBD = %d, R0 = %d; want the key to be kept", m.RAM[KBD], m.RAM[0])
+	}
+	if m.RAM[5] != 0xFFFF {
+		t.Errorf("address 32773 did not wrap to RAM[5]")
+	}
+
+	m = New()
+	m.PC = 0x7FFF
+	m.Step()
+	if m.PC != 0 || m.Cycles != 1 {
+		t.Errorf("PC after 32767 = %d, want 0", m.PC)
+	}
+	if err := m.Load(make([]uint16, ROMSize+1)); err == nil {
+		t.Error("loaded a program larger than ROM")
+	}
+}
+
+func TestReadHack(t *testing.T) {
+	code, err := ReadHack(strings.NewReader("0000000000000010\n\n1110110000010000\n"))
+	if err != nil || len(code) != 2 || code[0] != 2 || code[1] != 0xEC10 {
+		t.Errorf("got %v, %v", code, err)
+	}
+	if _, err := ReadHack(strings.NewReader("0000000000000010\n12\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("bad word: got %v", err)
+	}
+}
+
+func TestExpr(t *testing.T) {
+	m, syms := assemble(t, "@x\nM=1\n(LOOP)\n@LOOP\n0;JMP")
+	m.Run(2)
+	m.A, m.D = 16, 0xFFFE
+	m.RAM[0] = 5
+	tests := map[string]uint16{
+		"PC":                 2,
+		"LOOP":               2,
+		"PC == LOOP":         1,
+		"x":                  1,
+		"RAM[16] + M":        2,
+		"RAM[x + 15]":        1,
+		"D":                  0xFFFE,
+		"D < 0 && R0 > 4":    1,
+		"D > 0 || !R0":       0,
+		"-R0 * 2 + ~0":       0xFFF5,
+		"(1 + 2) * 3 & 0xF":  9,
+		"R0 - 6 >= 0":        0,
+		"KEY_LEFT == 130":    1,
+		"(SCREEN == 0) != 0": 1,
+		"R0 ^ 1 | 8":         12,
+		"!R0 == 0":           1,
+		"R0 > 9 ==> D == 0":  1,
+		"R0 == 5 ==> D == 0": 0,
+	}
+	for text, want := range tests {
+		if got := expr(t, text, syms).Value(m); got != want {
+			t.Errorf("%s = %d, want %d", text, got, want)
+		}
+	}
+	for _, text := range []string{"", "R0 ==", "nope", "RAM[1", "(1", "1 2", "R0 = 1", "70000", "old(R0)"} {
+		if _, err := ParseExpr(text, syms); err == nil {
+			t.Errorf("%q: no error", text)
+		}
+	}
+}
+
+func TestScreenGolden(t *testing.T) {
+	m, syms := assembleFile(t, "Rect.asm")
+	m.RAM[0] = 50
+	if !m.RunUntil(expr(t, "PC == INFINITE_LOOP", syms).True, 100000) {
+		t.Fatal("Rect did not finish")
+	}
+	want, err := ReadImage(filepath.Join("testdata", "Rect.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, box, err := PixelDiff(m.ScreenImage(), want); n != 0 || err != nil {
+		t.Errorf("Rect differs from Rect.png in %d pixels within %v (%v)", n, box, err)
+	}
+
+	// a 51st row of 16 pixels, and a stray pixel at the bottom right
+	m.RAM[Screen+50*32] = 0xFFFF
+	m.RAM[Screen+ScreenWords-1] = 0x8000
+	n, box, err := PixelDiff(m.ScreenImage(), want)
+	if err != nil || n != 17 || box != image.Rect(0, 50, ScreenWidth, ScreenHeight) {
+		t.Errorf("got %d pixels within %v (%v), want 17 within %v", n, box, err, image.Rect(0, 50, ScreenWidth, ScreenHeight))
+	}
+
+	var buf bytes.Buffer
+	if err := m.WriteScreenPNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _, _ := PixelDiff(img, m.ScreenImage()); n != 0 {
+		t.Errorf("PNG round trip changed %d pixels", n)
+	}
+	if _, _, err := PixelDiff(image.NewGray(image.Rect(0, 0, 10, 10)), want); err == nil {
+		t.Error("compared images of different sizes")
+	}
+}
+
+func TestKeyScript(t *testing.T) {
+	src := `// hold a key until Fill has blackened the screen
+at 100 press 'x'
+settled release   // then wait for it to go white again
+settled 500 press KEY_LEFT
+after 1000 press ' '
+after 10 press '\''
+after 10 press '\\'
+after 10 press '\n'
+after 10 press 200
+at 0 release
+`
+	script, err := ReadKeyScript(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	script.Write(&buf)
+	want := `at 100 press 'x'
+settled release
+settled 500 press KEY_LEFT
+after 1000 press ' '
+after 10 press '\''
+after 10 press '\\'
+after 10 press KEY_NEWLINE
+after 10 press 200
+at 0 release
+`
+	if buf.String() != want {
+		t.Errorf("written script:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	for _, bad := range []string{"at press 'x'", "at 5", "at 5 press", "at 5 press KEY_NOPE", "at 5 press 'ab'", "soon press 'x'", "settled 0 release", "at 5 release 'x'", "after 5 press 0"} {
+		if _, err := ReadKeyScript(strings.NewReader("at 1 release\n" + bad)); err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("%q: got %v, want an error on line 2", bad, err)
+		}
+	}
+}
+
+// TestKeyboard drives Fill with a script and checks a recording of the run replays exactly
+func TestKeyboard(t *testing.T) {
+	script, err := ReadKeyScript(strings.NewReader("at 1000 press 'x'\nsettled release\nsettled press KEY_DOWN\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := func(script *KeyScript) (*Machine, []byte) {
+		m, _ := assembleFile(t, "Fill.asm")
+		keyboard, recorder := NewKeyboard(script), &KeyRecorder{}
+		released := false
+		m.RunUntil(func(m *Machine) bool {
+			keyboard.Update(m)
+			recorder.Record(m)
+			if !released && m.Cycles > 1000 && m.RAM[KBD] == 0 {
+				// the screen settles once Fill has blackened all of it
+				released = true
+				if m.RAM[Screen] != 0xFFFF || m.RAM[KBD-1] != 0xFFFF {
+					t.Errorf("key released at cycle %d, before the screen was black", m.Cycles)
+				}
+			}
+			return false
+		}, 2000000)
+		if !keyboard.Done() {
+			t.Fatalf("script did not finish; at event %d", keyboard.next)
+		}
+		var buf bytes.Buffer
+		recorder.Script().Write(&buf)
+		return m, buf.Bytes()
+	}
+
+	m, recorded := run(script)
+	for _, w := range m.RAM[Screen : Screen+ScreenWords] {
+		if w != 0xFFFF {
+			t.Fatal("screen is not black with KEY_DOWN held at the end")
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(string(recorded)), "\n")
+	if len(lines) != 3 || lines[0] != "at 1000 press 'x'" || !strings.HasSuffix(lines[2], "press KEY_DOWN") {
+		t.Fatalf("recorded:\n%s", recorded)
+	}
+
+	replay, err := ReadKeyScript(bytes.NewReader(recorded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2, again := run(replay)
+	if !bytes.Equal(again, recorded) {
+		t.Errorf("replay recorded:\n%s\nwant:\n%s", again, recorded)
+	}
+	if m2.RAM != m.RAM || m2.PC != m.PC || m2.A != m.A || m2.D != m.D {
+		t.Error("replay ended in a different state")
+	}
+}
+
+// debug runs debugger commands and returns what they print
+func debug(d *Debugger, out *bytes.Buffer, commands ...string) string {
+	out.Reset()
+	for _, cmd := range commands {
+		d.Exec(cmd)
+	}
+	return out.String()
+}
+
+// TestDebugger drives the debugger through Prog, the 08 translator's output for a Main.main
+// that calls Main.double in a loop counting down from 3
+func TestDebugger(t *testing.T) {
+	m, syms := assembleFile(t, "Prog.asm")
+	var out bytes.Buffer
+	d := NewDebugger(m, syms, &out)
+
+	got := debug(d, &out, "break Main.double", "continue", "bt")
+	for _, want := range []string{
+		"breakpoint 1 at 296 <Main.double>\n=> 296 <Main.double>: @0\n",
+		"#0 Main.double at 296 <Main.double>\n#1 Main.main at 222 <Main.double$ret1>\n#2 Sys.init at",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("break and continue printed:\n%s\nwant it to contain:\n%s", got, want)
+		}
+	}
+	if got := debug(d, &out, "p RAM[ARG]"); got != "RAM[ARG] = 2 (0x0002)\n" {
+		t.Errorf("p RAM[ARG] printed %q", got)
+	}
+
+	// a conditional breakpoint on a VM label, and next over the call to Main.double
+	d.Exec("delete")
+	got = debug(d, &out, "b LOOP if RAM[LCL] == 1", "c", "b 221", "c")
+	if !strings.HasSuffix(got, "=> 221 <EVAL_0+112>: 0;JMP\n") {
+		t.Fatalf("did not stop at the call's jump:\n%s", got)
+	}
+	d.Exec("delete")
+	sp := m.RAM[0] // after the call pushed the frame
+	if got := debug(d, &out, "next"); got != "=> 222 <Main.double$ret1>: @0\n" {
+		t.Errorf("next printed %q", got)
+	}
+	// the return replaces the argument and the five words of the caller's frame with the result
+	if m.PC != 222 || m.RAM[0] != sp-5 || m.RAM[m.RAM[0]-1] != 0 {
+		t.Errorf("after next PC=%d SP=%d (want 222 and %d), top of stack %d (want double(0) = 0)", m.PC, m.RAM[0], sp-5, m.RAM[m.RAM[0]-1])
+	}
+
+	// an empty line repeats the last command
+	pc := m.PC
+	debug(d, &out, "step 2", "")
+	if m.PC != pc+4 {
+		t.Errorf("step 2 twice moved PC from %d to %d", pc, m.PC)
+	}
+
+	// step N stops at a breakpoint on the way, and steps off one it starts at
+	pc = m.PC
+	got = debug(d, &out, fmt.Sprintf("b %d", pc+2), "step 5")
+	if m.PC != pc+2 || !strings.Contains(got, fmt.Sprintf("breakpoint 4 at %d", pc+2)) {
+		t.Errorf("step 5 stopped at %d, want the breakpoint at %d:\n%s", m.PC, pc+2, got)
+	}
+	if debug(d, &out, "step"); m.PC != pc+3 {
+		t.Errorf("step from the breakpoint moved PC to %d, want %d", m.PC, pc+3)
+	}
+	d.Exec("delete")
+
+	got = debug(d, &out, "set RAM[300] = -3", "x 300 1", "b 296", "list 296", "frob", "b nowhere", "s x")
+	for _, want := range []string{
+		"RAM[300] = -3\n",
+		"(Main.double)\n  *   296  @0\n",
+		`unknown command "frob"`,
+		"no label nowhere",
+		`"x" is not a positive count`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output:\n%s\nwant it to contain %q", got, want)
+		}
+	}
+	if !d.Exec("quit") {
+		t.Error("quit did not quit")
+	}
+}
+
+// TestTrace records Prog and checks the trace reads back as what the machine did
+func TestTrace(t *testing.T) {
+	m, syms := assembleFile(t, "Prog.asm")
+	var buf bytes.Buffer
+	tracer, err := NewTracer(&buf, TraceFilter{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracer.RunUntil(m, func(*Machine) bool { return false }, 2000)
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	trace := buf.Bytes()
+
+	// replaying the writes on an empty machine gives the same RAM
+	replay := New()
+	tr, err := NewTraceReader(bytes.NewReader(trace))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := uint64(0)
+	for {
+		r, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Cycle != n {
+			t.Fatalf("record %d is for cycle %d", n, r.Cycle)
+		}
+		if r.Write {
+			if replay.RAM[r.Addr] != r.Old {
+				t.Fatalf("cycle %d: RAM[%d] was %d, but the trace says %d", r.Cycle, r.Addr, replay.RAM[r.Addr], r.Old)
+			}
+			replay.RAM[r.Addr] = r.New
+		}
+		n++
+	}
+	if n != 2000 || replay.RAM != m.RAM {
+		t.Errorf("replayed %d records; RAM matches: %v", n, replay.RAM == m.RAM)
+	}
+
+	// a filtered trace keeps only the stack writes inside Main.double
+	m, _ = assembleFile(t, "Prog.asm")
+	rom, err := syms.ParseROMSpans("Main.double")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ram, err := syms.ParseRAMSpans("stack")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	tracer, _ = NewTracer(&buf, TraceFilter{ROM: rom, RAM: ram}, 0)
+	tracer.RunUntil(m, func(*Machine) bool { return false }, 2000)
+	tracer.Close()
+	tr, _ = NewTraceReader(&buf)
+	r, err := tr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := r.Format(syms), "       276  303 <Main.double+7>      M=D        A=273    D=2       RAM[273]: 0 -> 2"; got != want {
+		t.Errorf("first record:\n%s\nwant:\n%s", got, want)
+	}
+	if tracer.Records != 12 {
+		t.Errorf("filtered trace has %d records, want 4 for each of 3 calls", tracer.Records)
+	}
+
+	// the size limit stops recording at a whole record
+	m, _ = assembleFile(t, "Prog.asm")
+	buf.Reset()
+	tracer, _ = NewTracer(&buf, TraceFilter{}, 100)
+	tracer.RunUntil(m, func(*Machine) bool { return false }, 2000)
+	tracer.Close()
+	if !tracer.Full || buf.Len() > 100 || !bytes.Equal(buf.Bytes(), trace[:buf.Len()]) {
+		t.Errorf("limited trace: full %v, %d bytes", tracer.Full, buf.Len())
+	}
+	tr, _ = NewTraceReader(bytes.NewReader(trace[:100]))
+	for err == nil {
+		_, err = tr.Next()
+	}
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("reading a cut trace: got %v, want unexpected EOF", err)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	_, syms := assembleFile(t, "Prog.asm")
+	for _, test := range []struct {
+		rom  bool
+		spec string
+		want []Span
+	}{
+		{true, "5", []Span{{5, 5}}},
+		{true, "5-9, 20", []Span{{5, 9}, {20, 20}}},
+		{true, "LOOP", []Span{{78, 108}}},             // to the next label, EVAL_0
+		{true, "Main.main", []Span{{51, 295}}},        // to the next function
+		{true, "LOOP-Main.double", []Span{{78, 295}}}, // a label ends a range just before it
+		{false, "stack", []Span{{256, 2047}}},
+		{false, "R0-R15,SCREEN", []Span{{0, 15}, {16384, 16384}}},
+		{false, "LCL-THAT", []Span{{1, 4}}},
+	} {
+		var got []Span
+		var err error
+		if test.rom {
+			got, err = syms.ParseROMSpans(test.spec)
+		} else {
+			got, err = syms.ParseRAMSpans(test.spec)
+		}
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %v, %v, want %v", test.spec, got, err, test.want)
+		}
+	}
+	for _, bad := range []string{"NOWHERE", "9-5", "LOOP-", "-3"} {
+		if _, err := syms.ParseROMSpans(bad); err == nil {
+			t.Errorf("%q: no error", bad)
+		}
+	}
+	if _, err := syms.ParseRAMSpans("LOOP"); err == nil {
+		t.Error("a label is not a RAM range")
+	}
+}
+
+func TestProfile(t *testing.T) {
+	m, syms := assembleFile(t, "Prog.asm")
+	p := NewProfile()
+	for i := 0; i < 2000; i++ {
+		p.Count(m)
+		m.Step()
+	}
+	if p.Total() != 2000 {
+		t.Errorf("total %d, want 2000", p.Total())
+	}
+	want := []ProfileEntry{{"Sys.init", 1082}, {"Main.main", 591}, {"Main.double", 276}, {"(none)", 51}}
+	if got := p.ByFunction(syms); !reflect.DeepEqual(got, want) {
+		t.Errorf("by function: got %v, want %v", got, want)
+	}
+	if got := p.ByLabel(syms); got[0] != (ProfileEntry{"HALT", 1029}) {
+		t.Errorf("by label: got %v first, want HALT", got[0])
+	}
+
+	var buf bytes.Buffer
+	if err := p.WriteReport(&buf, m, syms, 2); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"2000 instructions\n", "         276  13.80%  97.45%  Main.double\n", "         515  25.75%  25.75%  441 <HALT>               @441\n", "... 441 more\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("report:\n%s\nwant it to contain %q", buf.String(), want)
+		}
+	}
+
+	buf.Reset()
+	if err := p.WritePprof(&buf, syms, "Prog.hack"); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the profile starts with its sample type, the strings 1 and 2 of the string table
+	if !bytes.HasPrefix(data, []byte{0x0a, 0x04, 0x08, 0x01, 0x10, 0x02}) || !bytes.Contains(data, []byte("\x32\x0bMain.double")) {
+		t.Errorf("pprof profile does not start with a sample type or lacks Main.double: % x", data[:16])
+	}
+}
+
+// TestScripts runs the .tst scripts in testdata, as the course's CPU and VM emulators would
+func TestScripts(t *testing.T) {
+	scripts, _ := filepath.Glob("testdata/*.tst")
+	more, _ := filepath.Glob("testdata/*/*.tst")
+	for _, script := range append(scripts, more...) {
+		t.Run(filepath.Base(script), func(t *testing.T) {
+			r, err := RunTestScript(script)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Err(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestLoadVMAgain loads code twice, as a script with two load commands would
+func TestLoadVMAgain(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "Main.vm")
+	os.WriteFile(name, []byte("function Main.main 0\npush constant 7\npop static 0\nreturn\n"), 0644)
+	vm := NewVM(New())
+	for i := 0; i < 2; i++ {
+		if err := vm.LoadVM(name); err != nil {
+			t.Fatalf("load %d: %v", i+1, err)
+		}
+	}
+	if want := map[string]int{"Main.0": 16}; !reflect.DeepEqual(vm.statics, want) {
+		t.Errorf("statics %v after loading twice, want %v", vm.statics, want)
+	}
+}
+
+func TestScriptErrors(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "Mult.cmp"), []byte("| RAM[2] |\n|     15 |\n|     1* |\n|     99 |\n"), 0644)
+	run := func(src string) (*TestResult, error) {
+		name := filepath.Join(dir, "test.tst")
+		if err := os.WriteFile(name, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return RunTestScript(name)
+	}
+	mult, _ := filepath.Abs(shared["Mult.asm"])
+	r, err := run("load " + mult + ", compare-to Mult.cmp, output-list RAM[2]%D1.6.1;\n" +
+		"set RAM[0] 3, set RAM[1] 5; repeat 200 { ticktock; } output;\n" +
+		"set PC 0, set RAM[0] 2, set RAM[1] 7; repeat 200 { ticktock; } output;\n" +
+		"set PC 0, set RAM[0] 4, set RAM[1] 4; repeat 200 { ticktock; } output;\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Mismatch{{4, "|     16 |", "|     99 |"}}
+	if !reflect.DeepEqual(r.Mismatches, want) {
+		t.Errorf("mismatches %v, want %v", r.Mismatches, want)
+	}
+
+	for src, msg := range map[string]string{
+		"ticktock":                       "ticktock needs a , or ; after it",
+		"repeat 3 { ticktock; ":          "does not end with }",
+		"vmstep;":                        "line 1: vmstep needs VM code",
+		"frob;":                          "unknown command frob",
+		"set RAM[40000] 1;":              "bad address in RAM[40000]",
+		"output-list RAM[0]%Q1.2.3;":     "bad format %Q1.2.3",
+		"while RAM[0] ~ 1 { ticktock; }": "unknown comparison ~",
+		"load nowhere.hack;":             "no such file",
+		"\n\nset RAM[0] 1, /* two\nlines */ set D x;": "line 4: bad value x",
+	} {
+		if _, err := run(src); err == nil || !strings.Contains(err.Error(), msg) {
+			t.Errorf("%q: got %v, want an error with %q", src, err, msg)
+		}
+	}
+}
+
+func TestAssignment(t *testing.T) {
+	m, syms := assembleFile(t, "Mult.asm")
+	for _, text := range []string{"R0=3", "R1 = 5", "RAM[R0+10] = -1", "D=R0*R1", "PC=LOOP"} {
+		a, err := ParseAssignment(text, syms)
+		if err != nil {
+			t.Fatalf("%s: %v", text, err)
+		}
+		a.Apply(m)
+	}
+	if m.RAM[0] != 3 || m.RAM[1] != 5 || m.RAM[13] != 0xFFFF || m.D != 15 || int(m.PC) != syms.GetAddress("LOOP") {
+		t.Errorf("after the assignments R0=%d R1=%d RAM[13]=%d D=%d PC=%d", m.RAM[0], m.RAM[1], m.RAM[13], m.D, m.PC)
+	}
+	for _, bad := range []string{"R0", "=3", "LOOP=1", "3=4", "R0=", "RAM[1=2"} {
+		if _, err := ParseAssignment(bad, syms); err == nil {
+			t.Errorf("%q: no error", bad)
+		}
+	}
+
+	m.PC = 0
+	m.RunUntil(expr(t, "PC == END", syms).True, 10000)
+	for text, want := range map[string]string{
+		"R2=15":          "",
+		"R2 = 14":        "R2 = 15, want 14",
+		"R2 == 15":       "",
+		"R2 >= 16":       "R2 >= 16 does not hold",
+		"RAM[2]=R0*R1-1": "RAM[2] = 15, want 14",
+	} {
+		e, err := ParseExpectation(text, syms)
+		if err != nil {
+			t.Fatalf("%s: %v", text, err)
+		}
+		got := ""
+		if err := e.Check(m); err != nil {
+			got = err.Error()
+		}
+		if got != want {
+			t.Errorf("%s: got %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	m, syms := assembleFile(t, "Prog.asm")
+	m.Run(200)
+	var buf bytes.Buffer
+	if err := m.Snapshot().Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Run(600)
+	later := m.Snapshot()
+
+	// restoring and running again reaches the same state
+	if err := m.Restore(saved); err != nil {
+		t.Fatal(err)
+	}
+	m.Run(600)
+	if *m.Snapshot() != *later {
+		t.Errorf("the run from the restored snapshot differs")
+	}
+
+	d := DiffSnapshots(saved, later)
+	if d.Empty() || !d.SameProgram {
+		t.Fatalf("diff: %+v", d)
+	}
+	names := []string{}
+	for _, rc := range d.Regions {
+		names = append(names, rc.Name)
+	}
+	if want := []string{"R0-R15", "statics", "stack"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("regions changed: %v, want %v", names, want)
+	}
+	buf.Reset()
+	if err := d.Write(&buf, syms, 2); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"  PC: 153 <EVAL_0+44> -> 339 <Main.double+43>\n", "  cycles: 200 -> 800\n", "statics (16-255): 2 changed\n", "  RAM[0] R0: 268 -> 274\n", "  ... 3 more\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("diff:\n%s\nwant it to contain %q", buf.String(), want)
+		}
+	}
+	if !DiffSnapshots(later, later).Empty() {
+		t.Errorf("a snapshot differs from itself")
+	}
+
+	other, _ := assembleFile(t, "Mult.asm")
+	if err := other.Restore(saved); err == nil {
+		t.Errorf("restored a snapshot of another program")
+	}
+	if _, err := ReadSnapshot(strings.NewReader(snapshotMagic + "short")); err == nil {
+		t.Errorf("read a truncated snapshot")
+	}
+}
+
+func TestHalt(t *testing.T) {
+	m, syms := assembleFile(t, "Prog.asm")
+	if !m.RunUntil((*Machine).Halted, 5000) {
+		t.Fatalf("Prog did not halt")
+	}
+	if name, _, _ := syms.LabelAt(int(m.PC)); name != "HALT" {
+		t.Errorf("halted at %s, want HALT", syms.Locate(int(m.PC)))
+	}
+	cycles := m.Cycles
+	m.Run(100)
+	if m.RAM[0] != 261 || !m.Halted() {
+		t.Errorf("Prog changed after it halted at cycle %d", cycles)
+	}
+
+	for _, test := range []struct {
+		src    string
+		halted bool
+	}{
+		{"(END)\n@END\n0;JMP", true},
+		{"(END)\n@END\nD;JEQ", true},
+		{"(END)\n@END\nD;JNE", false},            // falls through
+		{"(END)\n@x\nM=M+1\n@END\n0;JMP", false}, // counts
+		{"(END)\n@END\nD=D+1;JMP", false},
+	} {
+		m, _ := assemble(t, test.src)
+		m.Run(20)
+		if m.Halted() != test.halted {
+			t.Errorf("%q: halted %v, want %v", test.src, !test.halted, test.halted)
+		}
+	}
+}
+
+// TestFastCore runs programs on the fast core and with Step side by side, including random
+// words, which cover every encoding, and checks that the machines stay the same
+func TestFastCore(t *testing.T) {
+	same := func(name string, fast, slow *Machine) {
+		t.Helper()
+		if fast.A != slow.A || fast.D != slow.D || fast.PC != slow.PC || fast.Cycles != slow.Cycles ||
+			fast.ScreenChanged != slow.ScreenChanged || fast.RAM != slow.RAM {
+			t.Fatalf("%s: fast core at PC=%d A=%d D=%d cycle %d, Step at PC=%d A=%d D=%d cycle %d",
+				name, fast.PC, fast.A, fast.D, fast.Cycles, slow.PC, slow.A, slow.D, slow.Cycles)
+		}
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		code := make([]uint16, 64)
+		for j := range code {
+			code[j] = uint16(rng.Intn(65536))
+			if j%2 == 0 {
+				code[j] = uint16(rng.Intn(64)) | uint16(rng.Intn(2))*Screen // jump nearby, or write the screen
+			}
+		}
+		fast := New()
+		fast.Load(code)
+		fast.SetKey('K')
+		slow := *fast
+		for n := 0; n < 500; n++ {
+			fast.Run(7)
+			for k := 0; k < 7; k++ {
+				slow.Step()
+			}
+			same("random program", fast, &slow)
+		}
+	}
+
+	for _, name := range []string{"Prog.asm", "Fill.asm", "Rect.asm"} {
+		fast, _ := assembleFile(t, name)
+		fast.SetKey('K')
+		slow := *fast
+		halted := fast.RunToHalt(50000, Halts{Loops: true})
+		for !slow.Halted() && slow.Cycles < 50000 {
+			slow.Step()
+		}
+		same(name, fast, &slow)
+		if halted != slow.Halted() {
+			t.Errorf("%s: RunToHalt reports halted %v, Halted %v", name, halted, slow.Halted())
+		}
+	}
+
+	m, syms := assembleFile(t, "Prog.asm")
+	fn, _ := syms.LabelAddress("Main.double")
+	if !m.RunToHalt(5000, Halts{At: []uint16{uint16(fn)}}) || int(m.PC) != fn {
+		t.Errorf("RunToHalt stopped at %s, want Main.double", syms.Locate(int(m.PC)))
+	}
+	if m.RunToHalt(10, Halts{}) || m.Cycles != 279 {
+		t.Errorf("RunToHalt with no halts ran to cycle %d, want 279", m.Cycles)
+	}
+
+	// the marks of RunToHalt's At stay in the shared code, but Run, here on a copy, ignores them
+	m.Reset()
+	if !m.RunToHalt(5000, Halts{At: []uint16{2}}) || m.PC != 2 {
+		t.Fatalf("RunToHalt stopped at PC %d, want 2", m.PC)
+	}
+	c := *m
+	c.Run(10)
+	if c.Cycles != m.Cycles+10 {
+		t.Errorf("Run after RunToHalt ran %d instructions, want 10", c.Cycles-m.Cycles)
+	}
+
+	// a ROM write needs ROMChanged before the fast core sees it
+	m, _ = assemble(t, "@5\nD=A\n@0\nM=D")
+	m.Run(4)
+	m.ROM[0] = 7
+	m.ROMChanged()
+	m.Reset()
+	m.Run(4)
+	if m.RAM[0] != 7 {
+		t.Errorf("after ROMChanged, R0 = %d, want 7", m.RAM[0])
+	}
+}
+
+// BenchmarkStep and BenchmarkRun run Fill, with a key held so it keeps blackening the
+// screen, on the reference Step and on the fast core, and report Hack instructions per second
+func BenchmarkStep(b *testing.B) {
+	benchmarkFill(b, func(m *Machine, n uint64) {
+		for ; n > 0; n-- {
+			m.Step()
+		}
+	})
+}
+
+func BenchmarkRun(b *testing.B) {
+	benchmarkFill(b, (*Machine).Run)
+}
+
+func BenchmarkRunToHalt(b *testing.B) {
+	benchmarkFill(b, func(m *Machine, n uint64) { m.RunToHalt(n, Halts{Loops: true}) })
+}
+
+func benchmarkFill(b *testing.B, run func(m *Machine, n uint64)) {
+	m, _ := assembleFile(b, "Fill.asm")
+	m.SetKey('K')
+	const n = 1000000
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		run(m, n)
+	}
+	b.ReportMetric(float64(n)*float64(b.N)/b.Elapsed().Seconds()/1e6, "Minstr/s")
+}
+
+func TestTextScreen(t *testing.T) {
+	m := New()
+	lines := m.TextScreen(256, 64, true)
+	if len(lines) != 64 || lines[0] != strings.Repeat(" ", 256) {
+		t.Fatalf("blank screen in braille: %d lines, first %q", len(lines), lines[0])
+	}
+	m.RAM[Screen] = 1                    // pixel 0, 0
+	m.RAM[Screen+ScreenWords-1] = 0x8000 // pixel 511, 255
+	m.RAM[Screen+32*2] = 2 | 4           // pixels 1 and 2, 2
+	if lines := m.TextScreen(256, 64, true); lines[0][:6] != "\u2821\u2804" || !strings.HasSuffix(lines[63], "\u2880") {
+		t.Errorf("braille at full size: first line %q, last ends %q", lines[0][:6], lines[63][len(lines[63])-3:])
+	}
+	lines = m.TextScreen(512, 200, false)
+	if len(lines) != 128 || utf8.RuneCountInString(lines[0]) != 512 || !strings.HasPrefix(lines[0], "▀ ") || !strings.HasPrefix(lines[1], " ▀▀ ") {
+		t.Errorf("half blocks at full size: %d lines, starting %q and %q", len(lines), lines[0][:8], lines[1][:8])
+	}
+
+	// a 64×100 terminal fits 4×4 pixels to a dot, and any black pixel sets it
+	lines = m.TextScreen(64, 100, true)
+	if len(lines) != 16 || utf8.RuneCountInString(lines[0]) != 64 {
+		t.Fatalf("scaled braille: %d lines of %d", len(lines), utf8.RuneCountInString(lines[0]))
+	}
+	if first, _ := utf8.DecodeRuneInString(lines[0]); first != 0x2801 || !strings.HasSuffix(lines[15], "\u2880") {
+		t.Errorf("scaled braille: first cell %q, last line %q", first, lines[15])
+	}
+	if m.TextScreen(0, 10, true) != nil {
+		t.Errorf("drew in no columns")
+	}
+}
+
+func TestTerminalKey(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		code uint16
+		n    int
+	}{
+		{"a", 'a', 1},
+		{"Ab", 'A', 1},
+		{"\r", 128, 1},
+		{"\x7f", 129, 1},
+		{"\x1b[D", 130, 3},
+		{"\x1b[A\x1b[A", 131, 3},
+		{"\x1bOC", 132, 3},
+		{"\x1b[1;5B", 133, 6},
+		{"\x1b[H", 134, 3},
+		{"\x1b[4~", 135, 4},
+		{"\x1b[5~", 136, 4},
+		{"\x1b[3~", 139, 4},
+		{"\x1b", 140, 1},
+		{"\x1bx", 140, 1},
+		{"\x1bOP", 141, 3},
+		{"\x1b[24~", 152, 5},
+		{"\x1b[99~", 0, 5},
+		{"\x1b[", 0, 2},
+		{"\t", 0, 1},
+	} {
+		if code, n := TerminalKey([]byte(test.in), true); code != test.code || n != test.n {
+			t.Errorf("%q: got key %d from %d bytes, want %d from %d", test.in, code, n, test.code, test.n)
+		}
+	}
+
+	// with more input to come, an escape sequence cut short waits for the rest
+	for in, want := range map[string]int{"\x1b": 0, "\x1b[": 0, "\x1bO": 0, "\x1b[1;5": 0, "\x1b[D": 3, "\x1bx": 1} {
+		if _, n := TerminalKey([]byte(in), false); n != want {
+			t.Errorf("%q with more to come: took %d bytes, want %d", in, n, want)
+		}
+	}
+}
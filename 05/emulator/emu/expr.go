@@ -0,0 +1,258 @@
+package emu
+
+import (
+	"fmt"
+	"strings"
+
+	"hack-assembler/asm"
+	hackexpr "hack-assembler/expr"
+)
+
+// Expressions test or inspect the machine's state, in the C syntax of the verifier's specs,
+// which package hack-assembler/expr parses for both:
+//
+//	PC == LOOP           PC, A and D are the registers, and a label is its ROM address
+//	RAM[0] > 5 && D < 0  RAM[n] is a cell, and M is RAM[A]
+//	R2 != 0 || KBD       a RAM symbol (R0-R15, SP, ..., a variable) is the value of its cell
+//	KBD == KEY_LEFT      KEY_ names are keyboard codes
+//
+// Numbers are decimal, or hexadecimal after 0x. Arithmetic wraps at 16 bits and comparisons
+// are signed, as on the Hack ALU. Comparisons, !, &&, || and ==> give 1 or 0, and a condition
+// holds if its value is not 0.
+
+// Expr is an expression ready to evaluate
+type Expr struct {
+	Text string
+	eval func(m *Machine) uint16
+}
+
+// Value evaluates the expression
+func (e *Expr) Value(m *Machine) uint16 {
+	return e.eval(m)
+}
+
+// True evaluates the expression as a condition
+func (e *Expr) True(m *Machine) bool {
+	return e.eval(m) != 0
+}
+
+// ParseExpr parses an expression, resolving names with syms
+func ParseExpr(text string, syms *Symbols) (*Expr, error) {
+	n, err := hackexpr.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	eval, err := compile(n, syms)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Text: text, eval: eval}, nil
+}
+
+type evalFunc = func(m *Machine) uint16
+
+func boolValue(b bool) uint16 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// binaryOps are the operators that evaluate both operands
+var binaryOps = map[string]func(x, y uint16) uint16{
+	"==": func(x, y uint16) uint16 { return boolValue(x == y) },
+	"!=": func(x, y uint16) uint16 { return boolValue(x != y) },
+	"<":  func(x, y uint16) uint16 { return boolValue(int16(x) < int16(y)) },
+	"<=": func(x, y uint16) uint16 { return boolValue(int16(x) <= int16(y)) },
+	">":  func(x, y uint16) uint16 { return boolValue(int16(x) > int16(y)) },
+	">=": func(x, y uint16) uint16 { return boolValue(int16(x) >= int16(y)) },
+	"+":  func(x, y uint16) uint16 { return x + y },
+	"-":  func(x, y uint16) uint16 { return x - y },
+	"|":  func(x, y uint16) uint16 { return x | y },
+	"^":  func(x, y uint16) uint16 { return x ^ y },
+	"*":  func(x, y uint16) uint16 { return x * y },
+	"&":  func(x, y uint16) uint16 { return x & y },
+}
+
+// compile turns a parsed expression into a function of the machine, resolving names with syms
+func compile(n *hackexpr.Node, syms *Symbols) (evalFunc, error) {
+	switch n.Op {
+	case "num":
+		return constantFunc(n.Num), nil
+	case "name":
+		return compileName(n.Name, syms)
+	case "call":
+		return nil, fmt.Errorf("unknown function %s", n.Name)
+	}
+
+	args := []evalFunc{}
+	for _, a := range n.Args {
+		x, err := compile(a, syms)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, x)
+	}
+	x := args[0]
+	switch {
+	case n.Op == "RAM":
+		return func(m *Machine) uint16 { return m.RAM[x(m)&0x7FFF] }, nil
+	case n.Unary() && n.Op == "-":
+		return func(m *Machine) uint16 { return -x(m) }, nil
+	case n.Unary() && n.Op == "~":
+		return func(m *Machine) uint16 { return ^x(m) }, nil
+	case n.Unary():
+		return func(m *Machine) uint16 { return boolValue(x(m) == 0) }, nil
+	}
+	y := args[1]
+	switch n.Op {
+	case "||":
+		return func(m *Machine) uint16 { return boolValue(x(m) != 0 || y(m) != 0) }, nil
+	case "&&":
+		return func(m *Machine) uint16 { return boolValue(x(m) != 0 && y(m) != 0) }, nil
+	case "==>":
+		return func(m *Machine) uint16 { return boolValue(x(m) == 0 || y(m) != 0) }, nil
+	}
+	op, ok := binaryOps[n.Op]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %s", n.Op)
+	}
+	return func(m *Machine) uint16 { return op(x(m), y(m)) }, nil
+}
+
+// compileName resolves a register, key name or symbol
+func compileName(name string, syms *Symbols) (evalFunc, error) {
+	switch name {
+	case "PC":
+		return func(m *Machine) uint16 { return m.PC }, nil
+	case "A":
+		return func(m *Machine) uint16 { return m.A }, nil
+	case "D":
+		return func(m *Machine) uint16 { return m.D }, nil
+	case "M":
+		return func(m *Machine) uint16 { return m.RAM[m.A&0x7FFF] }, nil
+	}
+	if code, ok := asm.KeyCode(name); ok {
+		return constantFunc(uint16(code)), nil
+	}
+	sym, ok := syms.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown symbol %s", name)
+	}
+	addr := uint16(sym.Address)
+	if sym.Kind == asm.Label {
+		return constantFunc(addr), nil
+	}
+	return func(m *Machine) uint16 { return m.RAM[addr&0x7FFF] }, nil
+}
+
+func constantFunc(v uint16) evalFunc {
+	return func(m *Machine) uint16 { return v }
+}
+
+// Assignment stores the value of an expression in a register or RAM cell. It is written
+// TARGET=EXPR, where the target is A, D, PC, M, RAM[expr] or a RAM symbol.
+type Assignment struct {
+	Text   string
+	Target string
+	store  func(m *Machine, v uint16)
+	value  *Expr
+}
+
+// ParseAssignment parses an assignment, resolving names with syms
+func ParseAssignment(text string, syms *Symbols) (*Assignment, error) {
+	target, value, ok := strings.Cut(text, "=")
+	target = strings.TrimSpace(target)
+	if !ok || target == "" {
+		return nil, fmt.Errorf("%q is not an assignment: want TARGET=VALUE, such as R0=3", text)
+	}
+	n, err := hackexpr.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	store, err := compileTarget(n, syms)
+	if err != nil {
+		return nil, err
+	}
+	e, err := ParseExpr(strings.TrimSpace(value), syms)
+	if err != nil {
+		return nil, err
+	}
+	return &Assignment{Text: text, Target: target, store: store, value: e}, nil
+}
+
+// Apply makes the assignment
+func (a *Assignment) Apply(m *Machine) {
+	a.store(m, a.value.Value(m))
+}
+
+// compileTarget turns the parsed left side of an assignment into a function that stores
+func compileTarget(n *hackexpr.Node, syms *Symbols) (func(m *Machine, v uint16), error) {
+	switch {
+	case n.Op == "RAM":
+		addr, err := compile(n.Args[0], syms)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Machine, v uint16) { m.RAM[addr(m)&0x7FFF] = v }, nil
+	case n.Op != "name":
+		return nil, fmt.Errorf("cannot assign to an expression: want A, D, PC, M, RAM[n] or a RAM symbol")
+	}
+	switch n.Name {
+	case "A":
+		return func(m *Machine, v uint16) { m.A = v }, nil
+	case "D":
+		return func(m *Machine, v uint16) { m.D = v }, nil
+	case "PC":
+		return func(m *Machine, v uint16) { m.PC = v & 0x7FFF }, nil
+	case "M":
+		return func(m *Machine, v uint16) { m.RAM[m.A&0x7FFF] = v }, nil
+	}
+	if sym, ok := syms.Lookup(n.Name); ok && sym.Kind != asm.Label {
+		addr := sym.Address & 0x7FFF
+		return func(m *Machine, v uint16) { m.RAM[addr] = v }, nil
+	}
+	return nil, fmt.Errorf("cannot assign to %s: want A, D, PC, M, RAM[n] or a RAM symbol", n.Name)
+}
+
+// Expectation checks the machine's state after a run. NAME=VALUE compares two expressions,
+// and anything else is a condition that must hold.
+type Expectation struct {
+	Text      string
+	got, want *Expr
+}
+
+// ParseExpectation parses an expectation, resolving names with syms
+func ParseExpectation(text string, syms *Symbols) (*Expectation, error) {
+	e := &Expectation{Text: text}
+	var err error
+	// a lone = compares; ==, !=, <= and >= belong to a condition
+	for i := 0; i < len(text); i++ {
+		if text[i] != '=' || i > 0 && strings.IndexByte("=!<>", text[i-1]) >= 0 || i+1 < len(text) && text[i+1] == '=' {
+			continue
+		}
+		if e.got, err = ParseExpr(strings.TrimSpace(text[:i]), syms); err != nil {
+			return nil, err
+		}
+		if e.want, err = ParseExpr(strings.TrimSpace(text[i+1:]), syms); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+	e.got, err = ParseExpr(text, syms)
+	return e, err
+}
+
+// Check returns an error saying how the machine's state differs from the expectation, or nil
+func (e *Expectation) Check(m *Machine) error {
+	if e.want == nil {
+		if !e.got.True(m) {
+			return fmt.Errorf("%s does not hold", e.Text)
+		}
+		return nil
+	}
+	if got, want := e.got.Value(m), e.want.Value(m); got != want {
+		return fmt.Errorf("%s = %d, want %d", e.got.Text, int16(got), int16(want))
+	}
+	return nil
+}
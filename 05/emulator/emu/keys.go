@@ -0,0 +1,258 @@
+package emu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"hack-assembler/asm"
+)
+
+// A keyboard script drives the KBD register over time, one event per line:
+//
+//	at 1000 press 'A'          at cycle 1000, hold down A
+//	after 5000 release         5000 cycles after the previous event, let go
+//	settled press KEY_LEFT     once the screen has not changed for 250000 cycles
+//	settled 50000 press ' '    ... or for 50000 cycles
+//
+// A key is a character in quotes (with the escapes '\n', '\\' and '\''), a KEY_ name for the
+// arrows and other special keys, or a number for any other code. Comments start with //.
+// Events happen in order, each once the one before it has.
+
+// DefaultSettle is how long the screen must stay unchanged for a "settled" event
+const DefaultSettle = 250000
+
+// KeyEvent is one line of a keyboard script
+type KeyEvent struct {
+	When  string // "at", "after" or "settled"
+	Count uint64 // the cycle, the cycles since the previous event, or the cycles the screen is unchanged
+	Key   uint16 // 0 for release
+	Line  int
+}
+
+// KeyScript is a timeline of key presses and releases
+type KeyScript struct {
+	Events []KeyEvent
+}
+
+// ReadKeyScript parses a keyboard script
+func ReadKeyScript(r io.Reader) (*KeyScript, error) {
+	s := &KeyScript{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := stripComment(scanner.Text())
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		e, err := parseKeyEvent(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		e.Line = line
+		s.Events = append(s.Events, e)
+	}
+	return s, scanner.Err()
+}
+
+// LoadKeyScript reads a keyboard script file
+func LoadKeyScript(name string) (*KeyScript, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s, err := ReadKeyScript(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return s, nil
+}
+
+// stripComment removes a // comment that is not inside quotes
+func stripComment(text string) string {
+	quoted := false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case quoted && text[i] == '\\':
+			i++
+		case text[i] == '\'':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(text[i:], "//"):
+			return text[:i]
+		}
+	}
+	return text
+}
+
+// parseKeyEvent reads "WHEN [COUNT] press KEY" or "WHEN [COUNT] release"
+func parseKeyEvent(text string) (KeyEvent, error) {
+	e := KeyEvent{}
+	fields := strings.Fields(text)
+	e.When = fields[0]
+	rest := fields[1:]
+	switch e.When {
+	case "at", "after":
+		if len(rest) == 0 {
+			return e, fmt.Errorf("%s needs a number of cycles", e.When)
+		}
+		n, err := strconv.ParseUint(rest[0], 10, 64)
+		if err != nil {
+			return e, fmt.Errorf("%q is not a number of cycles", rest[0])
+		}
+		e.Count, rest = n, rest[1:]
+	case "settled":
+		e.Count = DefaultSettle
+		if len(rest) > 0 && rest[0] != "press" && rest[0] != "release" {
+			n, err := strconv.ParseUint(rest[0], 10, 64)
+			if err != nil || n == 0 {
+				return e, fmt.Errorf("%q is not a number of cycles", rest[0])
+			}
+			e.Count, rest = n, rest[1:]
+		}
+	default:
+		return e, fmt.Errorf("unknown timing %q: want at, after or settled", e.When)
+	}
+
+	switch {
+	case len(rest) == 1 && rest[0] == "release":
+		return e, nil
+	case len(rest) >= 1 && rest[0] == "press":
+		// the key is everything after "press", so ' ' keeps its space
+		i := strings.Index(text, "press") + len("press")
+		key, err := parseKey(strings.TrimSpace(text[i:]))
+		e.Key = key
+		return e, err
+	}
+	return e, fmt.Errorf("want press KEY or release")
+}
+
+// parseKey reads a key: 'c', KEY_NAME or a code
+func parseKey(s string) (uint16, error) {
+	switch {
+	case s == "":
+		return 0, fmt.Errorf("press needs a key")
+	case len(s) >= 3 && s[0] == '\'' && s[len(s)-1] == '\'':
+		body := s[1 : len(s)-1]
+		switch body {
+		case `\n`:
+			return 128, nil
+		case `\\`, `\'`:
+			return uint16(body[1]), nil
+		}
+		if len(body) == 1 && body[0] >= ' ' && body[0] <= '~' {
+			return uint16(body[0]), nil
+		}
+	case strings.HasPrefix(s, "KEY_"):
+		if code, ok := asm.KeyCode(s); ok {
+			return uint16(code), nil
+		}
+	default:
+		if code, err := strconv.ParseUint(s, 10, 16); err == nil && code > 0 {
+			return uint16(code), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown key %s", s)
+}
+
+// keyString writes a key as a script reads it
+func keyString(code uint16) string {
+	switch {
+	case code == '\'' || code == '\\':
+		return `'\` + string(rune(code)) + `'`
+	case code >= ' ' && code <= '~':
+		return "'" + string(rune(code)) + "'"
+	}
+	if name, ok := asm.KeyName(int(code)); ok {
+		return name
+	}
+	return strconv.Itoa(int(code))
+}
+
+// String writes the event as a script line
+func (e KeyEvent) String() string {
+	when := fmt.Sprintf("%s %d", e.When, e.Count)
+	if e.When == "settled" && e.Count == DefaultSettle {
+		when = "settled"
+	}
+	if e.Key == 0 {
+		return when + " release"
+	}
+	return when + " press " + keyString(e.Key)
+}
+
+// Write writes the script, one event per line, without comments
+func (s *KeyScript) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range s.Events {
+		fmt.Fprintln(bw, e)
+	}
+	return bw.Flush()
+}
+
+// Keyboard plays a script into a machine's KBD register
+type Keyboard struct {
+	script *KeyScript
+	next   int
+	last   uint64 // cycle of the previous event
+}
+
+// NewKeyboard starts playing a script from its first event
+func NewKeyboard(s *KeyScript) *Keyboard {
+	return &Keyboard{script: s}
+}
+
+// Update presses or releases keys whose time has come. Call it before each instruction.
+func (k *Keyboard) Update(m *Machine) {
+	for k.next < len(k.script.Events) {
+		e := k.script.Events[k.next]
+		due := false
+		switch e.When {
+		case "at":
+			due = m.Cycles >= e.Count
+		case "after":
+			due = m.Cycles >= k.last+e.Count
+		case "settled":
+			since := k.last
+			if m.ScreenChanged > since {
+				since = m.ScreenChanged
+			}
+			due = m.Cycles >= since+e.Count
+		}
+		if !due {
+			return
+		}
+		m.SetKey(e.Key)
+		k.last = m.Cycles
+		k.next++
+	}
+}
+
+// Done reports whether every event has happened
+func (k *Keyboard) Done() bool {
+	return k.next == len(k.script.Events)
+}
+
+// KeyRecorder writes down changes to the KBD register as a script of "at" events, which
+// replays the same input at the same cycles
+type KeyRecorder struct {
+	script KeyScript
+	key    uint16
+}
+
+// Record notes the keyboard register's value at the machine's current cycle
+func (r *KeyRecorder) Record(m *Machine) {
+	if key := m.RAM[KBD]; key != r.key {
+		r.script.Events = append(r.script.Events, KeyEvent{When: "at", Count: m.Cycles, Key: key})
+		r.key = key
+	}
+}
+
+// Script returns the events recorded so far
+func (r *KeyRecorder) Script() *KeyScript {
+	return &r.script
+}
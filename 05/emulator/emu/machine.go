@@ -0,0 +1,195 @@
+// Package emu emulates the Hack computer of project 05: the CPU running a program from ROM,
+// with RAM, the memory-mapped screen and the keyboard register.
+package emu
+
+import "fmt"
+
+// Sizes and the memory map of the Hack computer
+const (
+	ROMSize     = 32768
+	RAMSize     = 32768
+	Screen      = 16384 // first word of the screen: 256 rows of 32 words, 16 pixels each
+	ScreenWords = 8192
+	KBD         = 24576 // code of the key being pressed, or 0
+)
+
+// Machine is the state of a Hack computer. Addresses are 15 bits wide, as on the CPU's
+// addressM and pc outputs, so they wrap at 32K.
+type Machine struct {
+	ROM    [ROMSize]uint16
+	RAM    [RAMSize]uint16
+	A, D   uint16
+	PC     uint16
+	Cycles uint64 // instructions executed since the last reset
+	Size   int    // words of program loaded into ROM
+
+	ScreenChanged uint64 // cycle of the last write that changed a pixel
+
+	code *code // ROM decoded for the fast core, or nil until a run needs it
+}
+
+// New creates a Machine with empty memory
+func New() *Machine {
+	return &Machine{}
+}
+
+// Load puts a program in ROM, clearing the rest of ROM, and resets the CPU
+func (m *Machine) Load(code []uint16) error {
+	if len(code) > ROMSize {
+		return fmt.Errorf("program has %d words, but ROM holds %d", len(code), ROMSize)
+	}
+	m.ROM = [ROMSize]uint16{}
+	copy(m.ROM[:], code)
+	m.Size = len(code)
+	m.code = nil
+	m.Reset()
+	return nil
+}
+
+// Reset restarts the program from ROM[0]. Like the reset input of the Hack computer, it
+// leaves RAM and the registers as they are.
+func (m *Machine) Reset() {
+	m.PC = 0
+	m.Cycles = 0
+	m.ScreenChanged = 0
+}
+
+// SetKey sets the keyboard register to a key code, or 0 for no key
+func (m *Machine) SetKey(code uint16) {
+	m.RAM[KBD] = code
+}
+
+// Step executes one instruction
+func (m *Machine) Step() {
+	instr := m.ROM[m.PC]
+	m.Cycles++
+	if instr&0x8000 == 0 {
+		m.A = instr
+		m.PC = (m.PC + 1) & 0x7FFF
+		return
+	}
+
+	// a C-instruction: 111a cccc ccdd djjj; the two bits after the opcode are not used
+	y := m.A
+	if instr&0x1000 != 0 {
+		y = m.RAM[m.A&0x7FFF]
+	}
+	out := alu(m.D, y, instr>>6)
+	if instr&0x0008 != 0 {
+		m.write(m.A, out)
+	}
+	next := m.PC + 1
+	if jumps(out, instr) {
+		// the PC loads the A register's value from before this instruction
+		next = m.A
+	}
+	if instr&0x0020 != 0 {
+		m.A = out
+	}
+	if instr&0x0010 != 0 {
+		m.D = out
+	}
+	m.PC = next & 0x7FFF
+}
+
+// write stores a value in RAM. The keyboard register is an input, so writes to it are lost.
+func (m *Machine) write(addr, value uint16) {
+	addr &= 0x7FFF
+	if addr == KBD {
+		return
+	}
+	if addr >= Screen && addr < KBD && m.RAM[addr] != value {
+		m.ScreenChanged = m.Cycles
+	}
+	m.RAM[addr] = value
+}
+
+// RunUntil executes instructions until cond holds after one, or max have run. It reports
+// whether cond was met.
+func (m *Machine) RunUntil(cond func(*Machine) bool, max uint64) bool {
+	for ; max > 0; max-- {
+		m.Step()
+		if cond(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// haltWindow is the longest loop Halted looks for
+const haltWindow = 8
+
+// Halted reports whether the program has stopped for good: the instructions from PC come
+// back to it, with A as it is now, without writing a register or RAM and without reading
+// the keyboard, so the machine can never leave the loop. Hack has no halt instruction, and
+// programs end in such a loop, like the translators' (INFINITE_LOOP) @INFINITE_LOOP 0;JMP.
+// A loop that loads A is seen from its second time round, once A holds the loop's value.
+func (m *Machine) Halted() bool {
+	a, pc := m.A, m.PC
+	for i := 0; i < haltWindow; i++ {
+		instr := m.ROM[pc]
+		if instr&0x8000 == 0 {
+			a = instr
+			pc = (pc + 1) & 0x7FFF
+		} else {
+			if instr&0x0038 != 0 {
+				return false
+			}
+			y := a
+			if instr&0x1000 != 0 {
+				if a&0x7FFF == KBD {
+					return false
+				}
+				y = m.RAM[a&0x7FFF]
+			}
+			next := pc + 1
+			if jumps(alu(m.D, y, instr>>6), instr) {
+				next = a
+			}
+			pc = next & 0x7FFF
+		}
+		if pc == m.PC && a == m.A {
+			return true
+		}
+	}
+	return false
+}
+
+// alu computes the Hack ALU's output from the control bits zx nx zy ny f no, in the low
+// six bits of control
+func alu(x, y, control uint16) uint16 {
+	if control&0x20 != 0 {
+		x = 0
+	}
+	if control&0x10 != 0 {
+		x = ^x
+	}
+	if control&0x08 != 0 {
+		y = 0
+	}
+	if control&0x04 != 0 {
+		y = ^y
+	}
+	var out uint16
+	if control&0x02 != 0 {
+		out = x + y
+	} else {
+		out = x & y
+	}
+	if control&0x01 != 0 {
+		out = ^out
+	}
+	return out
+}
+
+// jumps reports whether the jump bits j1 (out < 0), j2 (out = 0) and j3 (out > 0) of
+// instr select the ALU's output
+func jumps(out, instr uint16) bool {
+	switch {
+	case int16(out) < 0:
+		return instr&0x4 != 0
+	case out == 0:
+		return instr&0x2 != 0
+	}
+	return instr&0x1 != 0
+}
@@ -0,0 +1,143 @@
+package emu
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+)
+
+// protobuf builds a protocol buffer message, enough of the encoding for profile.proto
+type protobuf struct {
+	buf []byte
+}
+
+func (p *protobuf) varint(field int, v uint64) {
+	p.buf = binary.AppendUvarint(p.buf, uint64(field)<<3)
+	p.buf = binary.AppendUvarint(p.buf, v)
+}
+
+func (p *protobuf) bytes(field int, b []byte) {
+	p.buf = binary.AppendUvarint(p.buf, uint64(field)<<3|2)
+	p.buf = binary.AppendUvarint(p.buf, uint64(len(b)))
+	p.buf = append(p.buf, b...)
+}
+
+func (p *protobuf) message(field int, m *protobuf) {
+	p.bytes(field, m.buf)
+}
+
+// packed writes a repeated number field in the packed encoding
+func (p *protobuf) packed(field int, vs ...uint64) {
+	var m protobuf
+	for _, v := range vs {
+		m.buf = binary.AppendUvarint(m.buf, v)
+	}
+	p.bytes(field, m.buf)
+}
+
+// Field numbers of the messages in pprof's profile.proto
+const (
+	profileSampleType  = 1
+	profileSample      = 2
+	profileMapping     = 3
+	profileLocation    = 4
+	profileFunction    = 5
+	profileStringTable = 6
+	profilePeriodType  = 11
+	profilePeriod      = 12
+)
+
+// WritePprof writes the profile in the gzipped protocol buffer format that "go tool pprof"
+// reads. Each address executed is a location in the file program, with the ROM address as
+// its line number. Its stack is the label it follows, called from the VM function it is in,
+// so pprof's flat counts are by label and its cumulative counts by function.
+func (p *Profile) WritePprof(w io.Writer, syms *Symbols, program string) error {
+	var prof protobuf
+	index := map[string]uint64{"": 0}
+	table := []string{""}
+	str := func(s string) uint64 {
+		if i, ok := index[s]; ok {
+			return i
+		}
+		index[s] = uint64(len(table))
+		table = append(table, s)
+		return index[s]
+	}
+
+	var valueType protobuf
+	valueType.varint(1, str("instructions"))
+	valueType.varint(2, str("count"))
+	prof.message(profileSampleType, &valueType)
+
+	var mapping protobuf
+	mapping.varint(1, 1)                   // id
+	mapping.varint(3, ROMSize)             // memory_limit
+	mapping.varint(5, str(program))        // filename
+	for _, field := range []int{7, 8, 9} { // has_functions, has_filenames, has_line_numbers
+		mapping.varint(field, 1)
+	}
+	prof.message(profileMapping, &mapping)
+
+	// functions are numbered from 1 as they are first needed
+	functions := map[string]uint64{}
+	function := func(name string, start int) uint64 {
+		if id, ok := functions[name]; ok {
+			return id
+		}
+		id := uint64(len(functions) + 1)
+		functions[name] = id
+		var fn protobuf
+		fn.varint(1, id)
+		fn.varint(2, str(name))
+		fn.varint(3, str(name))
+		fn.varint(4, str(program))
+		fn.varint(5, uint64(start))
+		prof.message(profileFunction, &fn)
+		return id
+	}
+
+	for addr, n := range p.Counts {
+		if n == 0 {
+			continue
+		}
+		id := uint64(addr + 1)
+		var loc protobuf
+		loc.varint(1, id)
+		loc.varint(2, 1) // mapping
+		loc.varint(3, uint64(addr))
+		label, start := "(none)", 0
+		if name, off, ok := syms.LabelAt(addr); ok {
+			label, start = name, addr-off
+		}
+		// the innermost frame comes first
+		var line protobuf
+		line.varint(1, function(label, start))
+		line.varint(2, uint64(addr))
+		loc.message(4, &line)
+		if fn, ok := syms.FunctionAt(addr); ok && fn != label {
+			start, _ := syms.LabelAddress(fn)
+			var outer protobuf
+			outer.varint(1, function(fn, start))
+			outer.varint(2, uint64(addr))
+			loc.message(4, &outer)
+		}
+		prof.message(profileLocation, &loc)
+
+		var sample protobuf
+		sample.packed(1, id)
+		sample.packed(2, n)
+		prof.message(profileSample, &sample)
+	}
+
+	prof.message(profilePeriodType, &valueType)
+	prof.varint(profilePeriod, 1)
+	for _, s := range table {
+		prof.bytes(profileStringTable, []byte(s))
+	}
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(prof.buf); err != nil {
+		return err
+	}
+	return zw.Close()
+}
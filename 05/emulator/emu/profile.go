@@ -0,0 +1,120 @@
+package emu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Profile counts the instructions executed at each ROM address
+type Profile struct {
+	Counts [ROMSize]uint64
+}
+
+// NewProfile creates an empty profile
+func NewProfile() *Profile {
+	return &Profile{}
+}
+
+// Count notes the instruction a machine is about to execute. Call it before each Step.
+func (p *Profile) Count(m *Machine) {
+	p.Counts[m.PC]++
+}
+
+// Total returns the number of instructions counted
+func (p *Profile) Total() uint64 {
+	total := uint64(0)
+	for _, n := range p.Counts {
+		total += n
+	}
+	return total
+}
+
+// ProfileEntry is one line of a profile report
+type ProfileEntry struct {
+	Name  string
+	Count uint64
+}
+
+// group sums the counts by the name each address is given, most executed first
+func (p *Profile) group(name func(addr int) string) []ProfileEntry {
+	sums := map[string]uint64{}
+	for addr, n := range p.Counts {
+		if n > 0 {
+			sums[name(addr)] += n
+		}
+	}
+	entries := make([]ProfileEntry, 0, len(sums))
+	for name, n := range sums {
+		entries = append(entries, ProfileEntry{name, n})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// ByFunction sums the counts by VM function, from the function labels that the 08
+// translator writes. Code before the first function, such as the bootstrap, is "(none)".
+func (p *Profile) ByFunction(syms *Symbols) []ProfileEntry {
+	return p.group(func(addr int) string {
+		if fn, ok := syms.FunctionAt(addr); ok {
+			return fn
+		}
+		return "(none)"
+	})
+}
+
+// ByLabel sums the counts by the label each address follows
+func (p *Profile) ByLabel(syms *Symbols) []ProfileEntry {
+	return p.group(func(addr int) string {
+		if name, _, ok := syms.LabelAt(addr); ok {
+			return name
+		}
+		return "(none)"
+	})
+}
+
+// ByAddress lists the count of each address executed, with its label and instruction
+func (p *Profile) ByAddress(m *Machine, syms *Symbols) []ProfileEntry {
+	return p.group(func(addr int) string {
+		return fmt.Sprintf("%-24s %s", syms.Locate(addr), disassemble(m.ROM[addr]))
+	})
+}
+
+// WriteReport writes the counts by VM function, by label and, for the top most executed
+// addresses, by address
+func (p *Profile) WriteReport(w io.Writer, m *Machine, syms *Symbols, top int) error {
+	bw := bufio.NewWriter(w)
+	total := p.Total()
+	fmt.Fprintf(bw, "%d instructions\n", total)
+	section := func(title string, entries []ProfileEntry, limit int) {
+		fmt.Fprintf(bw, "\n%12s %7s %7s  %s\n", "count", "flat%", "sum%", title)
+		sum := uint64(0)
+		for i, e := range entries {
+			if limit > 0 && i == limit {
+				fmt.Fprintf(bw, "%12s  ... %d more\n", "", len(entries)-limit)
+				break
+			}
+			sum += e.Count
+			fmt.Fprintf(bw, "%12d %6.2f%% %6.2f%%  %s\n", e.Count, percent(e.Count, total), percent(sum, total), e.Name)
+		}
+	}
+	if fns := p.ByFunction(syms); len(fns) > 1 || len(fns) == 1 && fns[0].Name != "(none)" {
+		section("function", fns, 0)
+	}
+	section("label", p.ByLabel(syms), 0)
+	section("address", p.ByAddress(m, syms), top)
+	return bw.Flush()
+}
+
+func percent(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(total)
+}
@@ -0,0 +1,204 @@
+package emu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"diagnostic"
+	"hack-assembler/asm"
+)
+
+// ReadHack reads a program in the .hack format written by the assembler: one instruction
+// per line as 16 binary digits. Blank lines are skipped.
+func ReadHack(r io.Reader) ([]uint16, error) {
+	code := []uint16{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		word, err := asm.ParseWord(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		code = append(code, word)
+	}
+	return code, scanner.Err()
+}
+
+// LoadFile loads a .hack file into the machine
+func (m *Machine) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	code, err := ReadHack(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return m.Load(code)
+}
+
+// AssembleFile assembles a .asm file with the 06 assembler, returning its code and its
+// labels and variables. The error is the first error the assembler reports.
+func AssembleFile(name string) ([]uint16, *Symbols, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	prog := asm.Assemble(f)
+	diagnostic.SetFile(prog.Diags, name)
+	for _, d := range prog.Diags {
+		if d.Severity == diagnostic.Error {
+			return nil, nil, d
+		}
+	}
+	syms := NewSymbols()
+	for _, sym := range prog.Symbols.Symbols() {
+		if sym.Kind != asm.Predefined {
+			syms.AddEntry(sym)
+		}
+	}
+	return prog.Code, syms, nil
+}
+
+// Symbols names ROM and RAM addresses: the predefined symbols, and the labels and variables
+// from the assembler's .sym file
+type Symbols struct {
+	*asm.SymbolTable
+	sorted []*asm.Symbol  // labels by address, built when first needed
+	vars   map[int]string // variables by address, built when first needed
+}
+
+// NewSymbols creates a table of the predefined symbols
+func NewSymbols() *Symbols {
+	return &Symbols{SymbolTable: asm.NewSymbolTable()}
+}
+
+// ReadSymbols adds the labels and variables of a .sym file written by the assembler's --sym flag
+func (s *Symbols) ReadSymbols(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	syms, err := asm.ReadSymbols(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	for _, sym := range syms {
+		s.AddEntry(sym)
+	}
+	return nil
+}
+
+// AddEntry adds a symbol to the table
+func (s *Symbols) AddEntry(sym *asm.Symbol) {
+	s.SymbolTable.AddEntry(sym)
+	s.sorted, s.vars = nil, nil
+}
+
+// labels returns the labels sorted by address, then name
+func (s *Symbols) labels() []*asm.Symbol {
+	if s.sorted == nil {
+		s.sorted = []*asm.Symbol{}
+		for _, sym := range s.Symbols() {
+			if sym.Kind == asm.Label {
+				s.sorted = append(s.sorted, sym)
+			}
+		}
+		sort.SliceStable(s.sorted, func(i, j int) bool { return s.sorted[i].Address < s.sorted[j].Address })
+	}
+	return s.sorted
+}
+
+// LabelAt returns the last label at or before a ROM address and how far past it the address is
+func (s *Symbols) LabelAt(addr int) (string, int, bool) {
+	labels := s.labels()
+	i := sort.Search(len(labels), func(i int) bool { return labels[i].Address > addr }) - 1
+	if i < 0 {
+		return "", 0, false
+	}
+	// of several labels at one address, prefer the first by name, which is stable
+	for i > 0 && labels[i-1].Address == labels[i].Address {
+		i--
+	}
+	return labels[i].Name, addr - labels[i].Address, true
+}
+
+// Describe names a ROM address as LABEL or LABEL+offset, or returns "" if no label comes before it
+func (s *Symbols) Describe(addr int) string {
+	name, off, ok := s.LabelAt(addr)
+	switch {
+	case !ok:
+		return ""
+	case off == 0:
+		return name
+	}
+	return fmt.Sprintf("%s+%d", name, off)
+}
+
+// Locate names a ROM address by its number and, if there is one, its label: "12 <LOOP+2>"
+func (s *Symbols) Locate(addr int) string {
+	if label := s.Describe(addr); label != "" {
+		return fmt.Sprintf("%d <%s>", addr, label)
+	}
+	return fmt.Sprint(addr)
+}
+
+// IsFunction reports whether a label starts a VM function in the 08 translator's output,
+// where functions are named File.name and their labels and return addresses hold a $
+func IsFunction(label string) bool {
+	return strings.Contains(label, ".") && !strings.Contains(label, "$")
+}
+
+// FunctionAt returns the VM function whose code holds a ROM address: the last function
+// label at or before it
+func (s *Symbols) FunctionAt(addr int) (string, bool) {
+	labels := s.labels()
+	i := sort.Search(len(labels), func(i int) bool { return labels[i].Address > addr }) - 1
+	for ; i >= 0; i-- {
+		if IsFunction(labels[i].Name) {
+			return labels[i].Name, true
+		}
+	}
+	return "", false
+}
+
+// LabelAddress returns the ROM address of a label
+func (s *Symbols) LabelAddress(name string) (int, bool) {
+	sym, ok := s.Lookup(name)
+	if !ok || sym.Kind != asm.Label {
+		return 0, false
+	}
+	return sym.Address, true
+}
+
+// RAMName gives a RAM address the name it is usually known by: a variable, or Rn for the
+// first 16 addresses. It returns "" for other addresses.
+func (s *Symbols) RAMName(addr int) string {
+	if s.vars == nil {
+		s.vars = map[int]string{}
+		for _, sym := range s.Symbols() {
+			if sym.Kind == asm.Variable {
+				s.vars[sym.Address] = sym.Name
+			}
+		}
+	}
+	if name, ok := s.vars[addr]; ok {
+		return name
+	}
+	if addr < 16 {
+		return fmt.Sprintf("R%d", addr)
+	}
+	return ""
+}
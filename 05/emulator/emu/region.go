@@ -0,0 +1,128 @@
+package emu
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hack-assembler/asm"
+)
+
+// Span is a range of addresses, From to To inclusive
+type Span struct {
+	From, To int
+}
+
+// Contains reports whether an address is in the span
+func (s Span) Contains(addr int) bool {
+	return addr >= s.From && addr <= s.To
+}
+
+// Region is a named part of RAM, as the VM and Jack programs use it
+type Region struct {
+	Name string
+	Span
+}
+
+// Regions divides RAM the way the VM translator and the Jack OS use it
+var Regions = []Region{
+	{"R0-R15", Span{0, 15}},
+	{"statics", Span{16, 255}},
+	{"stack", Span{256, 2047}},
+	{"heap", Span{2048, Screen - 1}},
+	{"screen", Span{Screen, KBD - 1}},
+	{"keyboard", Span{KBD, KBD}},
+	{"unused", Span{KBD + 1, RAMSize - 1}},
+}
+
+// RegionOf returns the region holding a RAM address
+func RegionOf(addr int) Region {
+	for _, r := range Regions {
+		if r.Contains(addr) {
+			return r
+		}
+	}
+	return Regions[len(Regions)-1]
+}
+
+// ParseROMSpans reads a comma-separated list of ROM ranges. Each is an address, FROM-TO, a
+// label or LABEL-LABEL. A label alone covers the code up to the next label, or to the next
+// function for a VM function label, and a label at the end of a range stops just before it.
+func (s *Symbols) ParseROMSpans(spec string) ([]Span, error) {
+	return parseSpans(spec, func(part string, end bool) (int, int, error) {
+		if n, err := strconv.ParseUint(part, 10, 15); err == nil {
+			return int(n), int(n), nil
+		}
+		addr, ok := s.LabelAddress(part)
+		if !ok {
+			return 0, 0, fmt.Errorf("no label %s", part)
+		}
+		if end {
+			return addr - 1, addr - 1, nil
+		}
+		return addr, s.labelEnd(part, addr), nil
+	})
+}
+
+// labelEnd returns the last address of the code a label heads
+func (s *Symbols) labelEnd(name string, addr int) int {
+	labels := s.labels()
+	i := sort.Search(len(labels), func(i int) bool { return labels[i].Address > addr })
+	for ; i < len(labels); i++ {
+		if !IsFunction(name) || IsFunction(labels[i].Name) {
+			return labels[i].Address - 1
+		}
+	}
+	return ROMSize - 1
+}
+
+// ParseRAMSpans reads a comma-separated list of RAM ranges. Each is an address, FROM-TO, a
+// RAM symbol such as SP or a variable, SYMBOL-SYMBOL, or the name of one of the Regions.
+func (s *Symbols) ParseRAMSpans(spec string) ([]Span, error) {
+	return parseSpans(spec, func(part string, end bool) (int, int, error) {
+		for _, r := range Regions {
+			if r.Name == part {
+				return r.From, r.To, nil
+			}
+		}
+		if n, err := strconv.ParseUint(part, 10, 15); err == nil {
+			return int(n), int(n), nil
+		}
+		sym, ok := s.Lookup(part)
+		if !ok || sym.Kind == asm.Label {
+			return 0, 0, fmt.Errorf("%s is not a RAM address, symbol or region", part)
+		}
+		return sym.Address, sym.Address, nil
+	})
+}
+
+// parseSpans splits a list of ranges, using bounds to read each end: its first and last
+// address when it stands alone, or the address it gives as the end of a range
+func parseSpans(spec string, bounds func(part string, end bool) (int, int, error)) ([]Span, error) {
+	spans := []Span{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		// region names hold a dash too, so try the whole item first
+		from, to, err := bounds(item, false)
+		if err == nil {
+			spans = append(spans, Span{from, to})
+			continue
+		}
+		first, last, found := strings.Cut(item, "-")
+		if !found {
+			return nil, err
+		}
+		if from, _, err = bounds(first, false); err != nil {
+			return nil, err
+		}
+		if to, _, err = bounds(last, true); err != nil {
+			return nil, err
+		}
+		if from > to {
+			return nil, fmt.Errorf("range %s is backwards", item)
+		}
+		spans = append(spans, Span{from, to})
+	}
+	return spans, nil
+}
@@ -0,0 +1,90 @@
+package emu
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+)
+
+// Size of the Hack screen in pixels
+const (
+	ScreenWidth  = 512
+	ScreenHeight = 256
+)
+
+// ScreenImage renders the screen memory map: each row is 32 words, and the least significant
+// bit of a word is its leftmost pixel, black when set
+func (m *Machine) ScreenImage() *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, ScreenWidth, ScreenHeight))
+	for y := 0; y < ScreenHeight; y++ {
+		row := img.Pix[y*img.Stride : y*img.Stride+ScreenWidth]
+		for x := range row {
+			word := m.RAM[Screen+y*32+x/16]
+			row[x] = 255
+			if word>>(x%16)&1 == 1 {
+				row[x] = 0
+			}
+		}
+	}
+	return img
+}
+
+// WriteScreenPNG writes the screen as a PNG image
+func (m *Machine) WriteScreenPNG(w io.Writer) error {
+	return png.Encode(w, m.ScreenImage())
+}
+
+// SaveScreen writes the screen to a PNG file
+func (m *Machine) SaveScreen(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := m.WriteScreenPNG(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadImage reads a PNG file, such as a golden screen image
+func ReadImage(name string) (image.Image, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return img, nil
+}
+
+// PixelDiff counts the pixels that are black in one image and white in the other, and
+// returns the smallest rectangle holding them all. A pixel of any color counts as black
+// if it is darker than mid-gray, so golden images may be saved by other tools.
+func PixelDiff(got, want image.Image) (int, image.Rectangle, error) {
+	if got.Bounds().Size() != want.Bounds().Size() {
+		return 0, image.Rectangle{}, fmt.Errorf("images are %v and %v, not the same size", got.Bounds().Size(), want.Bounds().Size())
+	}
+	size := got.Bounds().Size()
+	g0, w0 := got.Bounds().Min, want.Bounds().Min
+	count, box := 0, image.Rectangle{}
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			if black(got.At(g0.X+x, g0.Y+y)) != black(want.At(w0.X+x, w0.Y+y)) {
+				count++
+				box = box.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return count, box, nil
+}
+
+func black(c color.Color) bool {
+	return color.GrayModel.Convert(c).(color.Gray).Y < 128
+}
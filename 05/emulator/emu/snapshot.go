@@ -0,0 +1,202 @@
+package emu
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// A snapshot file starts with snapshotMagic and holds, little-endian:
+//
+//	ROM hash  32 bytes  SHA-256 of the program loaded, as 16-bit words
+//	A, D, PC  uint16
+//	cycles    uint64
+//	RAM       32768 uint16
+const snapshotMagic = "HACKSNP1"
+
+// Snapshot is the state of a machine running a program, to compare or to restart from
+type Snapshot struct {
+	ROMHash  [sha256.Size]byte
+	A, D, PC uint16
+	Cycles   uint64
+	RAM      [RAMSize]uint16
+}
+
+// ROMHash identifies the program loaded in ROM
+func (m *Machine) ROMHash() [sha256.Size]byte {
+	buf := make([]byte, 2*m.Size)
+	for i, w := range m.ROM[:m.Size] {
+		binary.LittleEndian.PutUint16(buf[2*i:], w)
+	}
+	return sha256.Sum256(buf)
+}
+
+// Snapshot captures the machine's state
+func (m *Machine) Snapshot() *Snapshot {
+	return &Snapshot{ROMHash: m.ROMHash(), A: m.A, D: m.D, PC: m.PC, Cycles: m.Cycles, RAM: m.RAM}
+}
+
+// Restore puts the machine back in a snapshot's state. The snapshot must be of the program
+// the machine has loaded.
+func (m *Machine) Restore(s *Snapshot) error {
+	if s.ROMHash != m.ROMHash() {
+		return fmt.Errorf("the snapshot is of a different program")
+	}
+	m.A, m.D, m.PC, m.Cycles, m.RAM = s.A, s.D, s.PC, s.Cycles, s.RAM
+	m.ScreenChanged = s.Cycles // the screen is as the snapshot left it
+	return nil
+}
+
+// Write writes the snapshot in the snapshot file format
+func (s *Snapshot) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(snapshotMagic)
+	bw.Write(s.ROMHash[:])
+	binary.Write(bw, binary.LittleEndian, []uint16{s.A, s.D, s.PC})
+	binary.Write(bw, binary.LittleEndian, s.Cycles)
+	binary.Write(bw, binary.LittleEndian, s.RAM[:])
+	return bw.Flush()
+}
+
+// Save writes the snapshot to a file
+func (s *Snapshot) Save(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := s.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadSnapshot reads a snapshot file
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != snapshotMagic {
+		return nil, fmt.Errorf("not a snapshot file")
+	}
+	s := &Snapshot{}
+	if _, err := io.ReadFull(br, s.ROMHash[:]); err != nil {
+		return nil, truncated(err)
+	}
+	regs := make([]uint16, 3)
+	for _, v := range []interface{}{regs, &s.Cycles, s.RAM[:]} {
+		if err := binary.Read(br, binary.LittleEndian, v); err != nil {
+			return nil, truncated(err)
+		}
+	}
+	s.A, s.D, s.PC = regs[0], regs[1], regs[2]
+	return s, nil
+}
+
+// LoadSnapshot reads a snapshot from a file
+func LoadSnapshot(name string) (*Snapshot, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s, err := ReadSnapshot(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return s, nil
+}
+
+// Change is a register or RAM cell that differs between two snapshots
+type Change struct {
+	Name     string // the register, or "" for RAM
+	Addr     int
+	Old, New uint64
+}
+
+// RegionChanges are the RAM changes within one region
+type RegionChanges struct {
+	Region
+	Changes []Change
+}
+
+// SnapshotDiff lists what differs between two snapshots
+type SnapshotDiff struct {
+	SameProgram bool
+	Registers   []Change
+	Regions     []RegionChanges // only the regions with changes, in address order
+}
+
+// DiffSnapshots compares two snapshots
+func DiffSnapshots(old, new *Snapshot) *SnapshotDiff {
+	d := &SnapshotDiff{SameProgram: old.ROMHash == new.ROMHash}
+	for _, r := range []struct {
+		name     string
+		old, new uint64
+	}{
+		{"PC", uint64(old.PC), uint64(new.PC)},
+		{"A", uint64(old.A), uint64(new.A)},
+		{"D", uint64(old.D), uint64(new.D)},
+		{"cycles", old.Cycles, new.Cycles},
+	} {
+		if r.old != r.new {
+			d.Registers = append(d.Registers, Change{Name: r.name, Old: r.old, New: r.new})
+		}
+	}
+	for _, region := range Regions {
+		rc := RegionChanges{Region: region}
+		for addr := region.From; addr <= region.To; addr++ {
+			if old.RAM[addr] != new.RAM[addr] {
+				rc.Changes = append(rc.Changes, Change{Addr: addr, Old: uint64(old.RAM[addr]), New: uint64(new.RAM[addr])})
+			}
+		}
+		if len(rc.Changes) > 0 {
+			d.Regions = append(d.Regions, rc)
+		}
+	}
+	return d
+}
+
+// Empty reports whether the snapshots are the same
+func (d *SnapshotDiff) Empty() bool {
+	return d.SameProgram && len(d.Registers) == 0 && len(d.Regions) == 0
+}
+
+// Write lists the differences, region by region, showing at most max changes in each
+// (0 for all)
+func (d *SnapshotDiff) Write(w io.Writer, syms *Symbols, max int) error {
+	bw := bufio.NewWriter(w)
+	if !d.SameProgram {
+		fmt.Fprintln(bw, "the snapshots are of different programs")
+	}
+	if len(d.Registers) > 0 {
+		fmt.Fprintln(bw, "registers:")
+		for _, c := range d.Registers {
+			switch c.Name {
+			case "cycles":
+				fmt.Fprintf(bw, "  cycles: %d -> %d\n", c.Old, c.New)
+			case "PC":
+				fmt.Fprintf(bw, "  PC: %s -> %s\n", syms.Locate(int(c.Old)), syms.Locate(int(c.New)))
+			default:
+				fmt.Fprintf(bw, "  %s: %d -> %d\n", c.Name, int16(c.Old), int16(c.New))
+			}
+		}
+	}
+	for _, rc := range d.Regions {
+		fmt.Fprintf(bw, "%s (%d-%d): %d changed\n", rc.Name, rc.From, rc.To, len(rc.Changes))
+		for i, c := range rc.Changes {
+			if max > 0 && i == max {
+				fmt.Fprintf(bw, "  ... %d more\n", len(rc.Changes)-max)
+				break
+			}
+			fmt.Fprintf(bw, "  RAM[%d]", c.Addr)
+			if name := syms.RAMName(c.Addr); name != "" {
+				fmt.Fprintf(bw, " %s", name)
+			}
+			fmt.Fprintf(bw, ": %d -> %d\n", int16(c.Old), int16(c.New))
+		}
+	}
+	return bw.Flush()
+}
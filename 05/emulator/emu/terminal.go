@@ -0,0 +1,170 @@
+package emu
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"hack-assembler/asm"
+)
+
+// TextScreen draws the screen in characters, scaled to fit in cols columns and rows lines
+// with its shape kept. Each braille character shows 2×4 pixels, and otherwise each half
+// block shows 1×2. Set characters are black pixels. When the screen is scaled down, a dot is
+// set if any pixel it covers is black, so thin lines and text stay visible.
+func (m *Machine) TextScreen(cols, rows int, braille bool) []string {
+	cellW, cellH := 1, 2
+	if braille {
+		cellW, cellH = 2, 4
+	}
+	if cols < 1 || rows < 1 {
+		return nil
+	}
+	// pixels per dot, the same both ways, as a character cell is about twice as tall as wide
+	scale := math.Max(ScreenWidth/float64(cols*cellW), ScreenHeight/float64(rows*cellH))
+	dotsW := int(math.Ceil(ScreenWidth / scale))
+	dotsH := int(math.Ceil(ScreenHeight / scale))
+	dot := func(x, y int) bool {
+		if x >= dotsW || y >= dotsH {
+			return false
+		}
+		x0, x1 := span(x, scale, ScreenWidth)
+		y0, y1 := span(y, scale, ScreenHeight)
+		for py := y0; py < y1; py++ {
+			row := m.RAM[Screen+py*32 : Screen+py*32+32]
+			for px := x0; px < x1; px++ {
+				if row[px/16]>>(px%16)&1 == 1 {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	lines := make([]string, (dotsH+cellH-1)/cellH)
+	var b strings.Builder
+	for i := range lines {
+		b.Reset()
+		for j := 0; j < (dotsW+cellW-1)/cellW; j++ {
+			x, y := j*cellW, i*cellH
+			if braille {
+				b.WriteRune(brailleCell(func(dx, dy int) bool { return dot(x+dx, y+dy) }))
+			} else {
+				b.WriteRune(halfBlocks[boolBit(dot(x, y))|boolBit(dot(x, y+1))<<1])
+			}
+		}
+		lines[i] = b.String()
+	}
+	return lines
+}
+
+// span returns the pixels that dot i covers, at least one
+func span(i int, scale float64, size int) (int, int) {
+	from := int(float64(i) * scale)
+	to := int(float64(i+1) * scale)
+	if to <= from {
+		to = from + 1
+	}
+	if to > size {
+		to = size
+	}
+	return from, to
+}
+
+// halfBlocks are the characters for a cell's top and bottom pixel, set in bits 0 and 1
+var halfBlocks = [4]rune{' ', '▀', '▄', '█'}
+
+// brailleDots are the bits of the braille dots, by column and row of the cell
+var brailleDots = [2][4]rune{{0x01, 0x02, 0x04, 0x40}, {0x08, 0x10, 0x20, 0x80}}
+
+// brailleCell returns the braille character with the dots that set holds, or a space for
+// none, which terminals draw the same but is a third of the bytes
+func brailleCell(set func(dx, dy int) bool) rune {
+	var bits rune
+	for dx := 0; dx < 2; dx++ {
+		for dy := 0; dy < 4; dy++ {
+			if set(dx, dy) {
+				bits |= brailleDots[dx][dy]
+			}
+		}
+	}
+	if bits == 0 {
+		return ' '
+	}
+	return 0x2800 + bits
+}
+
+func boolBit(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// terminalKeys are the escape sequences of the special keys, as xterm and most terminals
+// send them: the final byte of CSI A to D, H and F or, before ~, its number
+var terminalKeys = map[string]string{
+	"A": "KEY_UP", "B": "KEY_DOWN", "C": "KEY_RIGHT", "D": "KEY_LEFT", "H": "KEY_HOME", "F": "KEY_END",
+	"P": "KEY_F1", "Q": "KEY_F2", "R": "KEY_F3", "S": "KEY_F4",
+	"1~": "KEY_HOME", "7~": "KEY_HOME", "4~": "KEY_END", "8~": "KEY_END",
+	"2~": "KEY_INSERT", "3~": "KEY_DELETE", "5~": "KEY_PAGEUP", "6~": "KEY_PAGEDOWN",
+	"11~": "KEY_F1", "12~": "KEY_F2", "13~": "KEY_F3", "14~": "KEY_F4", "15~": "KEY_F5",
+	"17~": "KEY_F6", "18~": "KEY_F7", "19~": "KEY_F8", "20~": "KEY_F9", "21~": "KEY_F10",
+	"23~": "KEY_F11", "24~": "KEY_F12",
+}
+
+// TerminalKey reads the first key from a terminal's input in raw mode, and returns its
+// Hack keyboard code and the bytes it took. The code is 0 for input that is not a Hack key,
+// such as a control character or an unknown escape sequence. An escape sequence cut short at
+// the end of the input takes no bytes, so the caller can wait for the rest, unless final says
+// no more input is coming. Then an escape with nothing after it is the Esc key.
+func TerminalKey(in []byte, final bool) (uint16, int) {
+	if len(in) == 0 {
+		return 0, 0
+	}
+	key := func(name string) uint16 {
+		code, _ := asm.KeyCode(name)
+		return uint16(code)
+	}
+	switch c := in[0]; {
+	case c == '\r' || c == '\n':
+		return key("KEY_NEWLINE"), 1
+	case c == 0x7F || c == 0x08:
+		return key("KEY_BACKSPACE"), 1
+	case c >= ' ' && c <= '~':
+		return uint16(c), 1
+	case c != 0x1B:
+		return 0, 1
+	}
+	if len(in) == 1 && !final {
+		return 0, 0
+	}
+	if len(in) < 2 || in[1] != '[' && in[1] != 'O' {
+		return key("KEY_ESC"), 1
+	}
+
+	// ESC [ parameters final, or ESC O final; modifiers such as the 5 of ESC [1;5A are dropped
+	n := 2
+	for n < len(in) && (in[n] < 0x40 || in[n] > 0x7E) {
+		n++
+	}
+	if n == len(in) {
+		if !final {
+			return 0, 0
+		}
+		return 0, n
+	}
+	params, last := string(in[2:n]), string(in[n])
+	n++
+	if last == "~" {
+		number, _, _ := strings.Cut(params, ";")
+		if _, err := strconv.Atoi(number); err != nil {
+			return 0, n
+		}
+		last = number + "~"
+	}
+	if name, ok := terminalKeys[last]; ok {
+		return key(name), n
+	}
+	return 0, n
+}
@@ -0,0 +1,35 @@
+// Mult.tst in the form of the course's project 04 test, for the repo's 04 Mult.asm: each
+// product from RAM[0] and RAM[1]
+load ../../../../04/mult/Mult.asm,
+output-file Mult.out,
+compare-to Mult.cmp,
+output-list RAM[0]%D2.6.2 RAM[1]%D2.6.2 RAM[2]%D2.6.2;
+
+set PC 0,
+set RAM[0] 0,
+set RAM[1] 0,
+set RAM[2] -1;  // the program must clear the product
+repeat 20 {
+  ticktock;
+}
+output;
+
+set PC 0,
+set RAM[0] 3,
+set RAM[1] 1,
+set RAM[2] -1;
+repeat 50 {
+  ticktock;
+}
+output;
+
+set PC 0,
+set RAM[0] 6,
+set RAM[1] 7,
+set RAM[2] -1;
+/* enough cycles for any order of the operands */
+repeat 200 {
+  ticktock;
+}
+echo "6 times 7 done";
+output;
@@ -0,0 +1,484 @@
+// initialize program state
+(bootstrap)
+	@256
+	D=A
+	@SP
+	M=D
+	@Sys.init$ret0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@5
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Sys.init
+	0;JMP
+(Sys.init$ret0)
+// function Main.main 1
+(Main.main)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP
+(LOOP)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_0
+	D;JEQ
+	@R13
+	M=0
+(EVAL_0)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto END
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@END
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Main.double 1
+	@Main.double$ret1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Main.double
+	0;JMP
+(Main.double$ret1)
+// pop temp 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@5
+	M=D
+// goto LOOP
+	@LOOP
+	0;JMP
+// label END
+(END)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Main.double 0
+(Main.double)
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Sys.init 0
+(Sys.init)
+// call Main.main 0
+	@Main.main$ret2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@5
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Main.main
+	0;JMP
+(Main.main$ret2)
+// pop temp 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@5
+	M=D
+// label HALT
+(HALT)
+// goto HALT
+	@HALT
+	0;JMP
@@ -0,0 +1,10 @@
+// Runs the 08 translator's code for ProgVM on the CPU, to compare with ProgVM/ProgVME.tst
+load Prog.asm,
+output-file Prog.out,
+compare-to ProgVM/Prog.cmp,
+output-list RAM[0]%D1.6.1 RAM[5]%D1.6.1 RAM[262]%D1.6.1 RAM[263]%D1.6.1;
+
+repeat 2000 {
+  ticktock;
+}
+output;
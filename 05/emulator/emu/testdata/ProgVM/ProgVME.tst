@@ -0,0 +1,15 @@
+// Runs Main.vm and Sys.vm on the VM, set up as the bootstrap code would leave it
+load,
+output-file ProgVME.out,
+compare-to Prog.cmp,
+output-list RAM[0]%D1.6.1 RAM[5]%D1.6.1 RAM[262]%D1.6.1 RAM[263]%D1.6.1;
+
+set sp 261,
+set local 261,
+set argument 256,
+set this 3000,
+set that 4000;
+repeat 200 {
+  vmstep;
+}
+output;
@@ -0,0 +1,199 @@
+package emu
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A trace file starts with traceMagic, followed by one record per instruction traced:
+//
+//	cycle    uvarint  cycles since the previous record (the first counts from 0)
+//	PC       uint16   address of the instruction
+//	instr    uint16   the instruction
+//	A, D     uint16   the registers after it
+//	address  uint16   only if the instruction writes M: the address written,
+//	old, new uint16   the word there before and after
+//
+// Numbers are little-endian. Most records take 9 bytes, or 15 with a write.
+const traceMagic = "HACKTRC1"
+
+// TraceRecord is one instruction in a trace
+type TraceRecord struct {
+	Cycle     uint64 // cycles executed before the instruction
+	PC, Instr uint16
+	A, D      uint16
+	Write     bool // whether the instruction writes M
+	Addr      uint16
+	Old, New  uint16
+}
+
+// writesM reports whether an instruction is a C-instruction that stores in M
+func writesM(instr uint16) bool {
+	return instr&0x8000 != 0 && instr&0x0008 != 0
+}
+
+// TraceFilter chooses the instructions to trace. ROM spans keep instructions at those
+// addresses, and RAM spans keep those that write there. An empty list keeps everything.
+type TraceFilter struct {
+	ROM, RAM []Span
+}
+
+// Match reports whether the filter keeps a record
+func (f *TraceFilter) Match(r *TraceRecord) bool {
+	return inSpans(f.ROM, int(r.PC)) && (len(f.RAM) == 0 || r.Write && inSpans(f.RAM, int(r.Addr)))
+}
+
+func inSpans(spans []Span, addr int) bool {
+	if len(spans) == 0 {
+		return true
+	}
+	for _, s := range spans {
+		if s.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tracer runs a machine and records what each instruction does
+type Tracer struct {
+	w       *bufio.Writer
+	Filter  TraceFilter
+	Max     int64  // most bytes to write, or 0 for no limit
+	Size    int64  // bytes written
+	Records uint64 // instructions recorded
+	Full    bool   // whether recording stopped at Max
+	last    uint64 // cycle of the previous record
+	err     error
+}
+
+// NewTracer starts a trace written to w
+func NewTracer(w io.Writer, filter TraceFilter, max int64) (*Tracer, error) {
+	t := &Tracer{w: bufio.NewWriter(w), Filter: filter, Max: max}
+	_, err := t.w.WriteString(traceMagic)
+	t.Size = int64(len(traceMagic))
+	return t, err
+}
+
+// Step executes one instruction, recording it if the filter keeps it
+func (t *Tracer) Step(m *Machine) {
+	r := TraceRecord{Cycle: m.Cycles, PC: m.PC, Instr: m.ROM[m.PC]}
+	if writesM(r.Instr) {
+		r.Write, r.Addr = true, m.A&0x7FFF
+		r.Old = m.RAM[r.Addr]
+	}
+	m.Step()
+	if t.Full || t.err != nil || !t.Filter.Match(&r) {
+		return
+	}
+	r.A, r.D = m.A, m.D
+	if r.Write {
+		r.New = m.RAM[r.Addr]
+	}
+	t.record(&r)
+}
+
+// RunUntil executes instructions like Machine.RunUntil, tracing each
+func (t *Tracer) RunUntil(m *Machine, cond func(*Machine) bool, max uint64) bool {
+	for ; max > 0; max-- {
+		t.Step(m)
+		if cond(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// record writes a record unless it would take the trace past its size limit
+func (t *Tracer) record(r *TraceRecord) {
+	var buf [binary.MaxVarintLen64 + 14]byte
+	n := binary.PutUvarint(buf[:], r.Cycle-t.last)
+	for _, v := range []uint16{r.PC, r.Instr, r.A, r.D} {
+		binary.LittleEndian.PutUint16(buf[n:], v)
+		n += 2
+	}
+	if r.Write {
+		for _, v := range []uint16{r.Addr, r.Old, r.New} {
+			binary.LittleEndian.PutUint16(buf[n:], v)
+			n += 2
+		}
+	}
+	if t.Max > 0 && t.Size+int64(n) > t.Max {
+		t.Full = true
+		return
+	}
+	_, t.err = t.w.Write(buf[:n])
+	t.Size += int64(n)
+	t.Records++
+	t.last = r.Cycle
+}
+
+// Close flushes the trace and returns the first error in writing it
+func (t *Tracer) Close() error {
+	if err := t.w.Flush(); t.err == nil {
+		t.err = err
+	}
+	return t.err
+}
+
+// TraceReader reads the records of a trace file
+type TraceReader struct {
+	r    *bufio.Reader
+	last uint64
+}
+
+// NewTraceReader checks that r holds a trace and gets ready to read its records
+func NewTraceReader(r io.Reader) (*TraceReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(traceMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != traceMagic {
+		return nil, fmt.Errorf("not a trace file")
+	}
+	return &TraceReader{r: br}, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the trace
+func (tr *TraceReader) Next() (*TraceRecord, error) {
+	delta, err := binary.ReadUvarint(tr.r)
+	if err != nil {
+		return nil, err
+	}
+	var words [7]uint16
+	if err := binary.Read(tr.r, binary.LittleEndian, words[:4]); err != nil {
+		return nil, truncated(err)
+	}
+	r := &TraceRecord{Cycle: tr.last + delta, PC: words[0], Instr: words[1], A: words[2], D: words[3]}
+	if writesM(r.Instr) {
+		if err := binary.Read(tr.r, binary.LittleEndian, words[4:]); err != nil {
+			return nil, truncated(err)
+		}
+		r.Write, r.Addr, r.Old, r.New = true, words[4], words[5], words[6]
+	}
+	tr.last = r.Cycle
+	return r, nil
+}
+
+// truncated reports a trace that ends partway through a record
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Format writes a record as a line of text: the cycle, the instruction's address, label and
+// assembly, the registers after it and any RAM write
+func (r *TraceRecord) Format(syms *Symbols) string {
+	line := fmt.Sprintf("%10d  %-24s %-10s A=%-6d D=%d", r.Cycle, syms.Locate(int(r.PC)), disassemble(r.Instr), int16(r.A), int16(r.D))
+	if !r.Write {
+		return line
+	}
+	line = fmt.Sprintf("%-65s  RAM[%d]", line, r.Addr)
+	if name := syms.RAMName(int(r.Addr)); name != "" {
+		line += " " + name
+	}
+	return line + fmt.Sprintf(": %d -> %d", int16(r.Old), int16(r.New))
+}
@@ -0,0 +1,570 @@
+package emu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// A test script is the course's .tst format for the CPU and VM emulators. Commands end with
+// "," or ";", blocks are in braces, and comments are // or /* */:
+//
+//	load Mult.asm, output-file Mult.out, compare-to Mult.cmp,
+//	output-list RAM[0]%D2.6.2 RAM[1]%D2.6.2 RAM[2]%D2.6.2;
+//	set RAM[0] 3, set RAM[1] 5;
+//	repeat 100 { ticktock; }
+//	while PC <> 18 { ticktock; }
+//	output;
+//
+// load takes a .hack or .asm file for the CPU, or a .vm file or directory for the VM (a
+// bare load is the script's directory). ticktock runs an instruction and vmstep a VM
+// command. Each output writes a row of the output-list variables, and the rows are checked
+// against the compare-to file once the script ends.
+
+// TestResult is the outcome of a test script
+type TestResult struct {
+	Script      string
+	Output      []string // the lines written: the output-list header and a row for each output
+	OutputFile  string   // the output-file, if the script named one
+	CompareFile string   // the compare-to file, if the script named one
+	Mismatches  []Mismatch
+	Echo        []string // messages from echo
+}
+
+// Mismatch is a row of output that differs from the compare file
+type Mismatch struct {
+	Line      int
+	Got, Want string
+}
+
+// Err summarizes the mismatches, or returns nil if there are none
+func (r *TestResult) Err() error {
+	if len(r.Mismatches) == 0 {
+		return nil
+	}
+	first := r.Mismatches[0]
+	return fmt.Errorf("%s: %d of %d lines differ from %s; first at line %d:\n  got  %s\n  want %s",
+		r.Script, len(r.Mismatches), len(r.Output), r.CompareFile, first.Line, first.Got, first.Want)
+}
+
+// tstCommand is a command of a test script, with the body of a repeat or while
+type tstCommand struct {
+	words []string
+	line  int
+	body  []tstCommand
+}
+
+// column is an output-list variable and its format: %F(left).(width).(right)
+type column struct {
+	name               string
+	format             byte
+	left, width, right int
+}
+
+// testRun is the state of a running test script
+type testRun struct {
+	dir     string
+	m       *Machine
+	vm      *VM
+	columns []column
+	result  *TestResult
+}
+
+// RunTestScript runs a test script against the emulator. The error is for scripts that
+// cannot run; rows that differ from the compare file are the result's Mismatches.
+func RunTestScript(name string) (*TestResult, error) {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	commands, err := parseTestScript(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	run := &testRun{dir: filepath.Dir(name), m: New(), result: &TestResult{Script: name}}
+	if err := run.exec(commands); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	if run.result.CompareFile != "" {
+		if err := run.compare(); err != nil {
+			return nil, err
+		}
+	}
+	return run.result, nil
+}
+
+// tstTokens splits a script into words, quoted strings and the punctuation , ; { }, with
+// the line of each
+func tstTokens(src string) ([]string, []int, error) {
+	tokens, lines := []string{}, []int{}
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, nil, fmt.Errorf("line %d: comment does not end", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			end := strings.IndexAny(src[i+1:], "\"\n")
+			if end < 0 || src[i+1+end] != '"' {
+				return nil, nil, fmt.Errorf("line %d: string does not end", line)
+			}
+			tokens, lines = append(tokens, src[i:i+2+end]), append(lines, line)
+			i += end + 2
+		case strings.IndexByte(",;{}", c) >= 0:
+			tokens, lines = append(tokens, string(c)), append(lines, line)
+			i++
+		default:
+			start := i
+			for i < len(src) && strings.IndexByte(" \t\r\n,;{}\"", src[i]) < 0 && !strings.HasPrefix(src[i:], "//") {
+				i++
+			}
+			tokens, lines = append(tokens, src[start:i]), append(lines, line)
+		}
+	}
+	return tokens, lines, nil
+}
+
+// parseTestScript reads a script into commands
+func parseTestScript(src string) ([]tstCommand, error) {
+	tokens, lines, err := tstTokens(src)
+	if err != nil {
+		return nil, err
+	}
+	pos := 0
+	var block func(nested bool) ([]tstCommand, error)
+	block = func(nested bool) ([]tstCommand, error) {
+		commands := []tstCommand{}
+		cmd := tstCommand{}
+		for ; pos < len(tokens); pos++ {
+			tok := tokens[pos]
+			if len(cmd.words) == 0 {
+				cmd.line = lines[pos]
+			}
+			switch tok {
+			case ",", ";":
+				if len(cmd.words) > 0 {
+					commands = append(commands, cmd)
+				}
+				cmd = tstCommand{}
+			case "{":
+				if len(cmd.words) == 0 || cmd.words[0] != "repeat" && cmd.words[0] != "while" {
+					return nil, fmt.Errorf("line %d: a block must follow repeat or while", lines[pos])
+				}
+				pos++
+				if cmd.body, err = block(true); err != nil {
+					return nil, err
+				}
+				commands = append(commands, cmd)
+				cmd = tstCommand{}
+			case "}":
+				if !nested {
+					return nil, fmt.Errorf("line %d: } without {", lines[pos])
+				}
+				if len(cmd.words) > 0 {
+					return nil, fmt.Errorf("line %d: %s needs a , or ; after it", cmd.line, cmd.words[0])
+				}
+				return commands, nil
+			default:
+				cmd.words = append(cmd.words, tok)
+			}
+		}
+		if nested {
+			return nil, fmt.Errorf("a block does not end with }")
+		}
+		if len(cmd.words) > 0 {
+			return nil, fmt.Errorf("line %d: %s needs a , or ; after it", cmd.line, cmd.words[0])
+		}
+		return commands, nil
+	}
+	return block(false)
+}
+
+// exec runs commands in order
+func (t *testRun) exec(commands []tstCommand) error {
+	for _, cmd := range commands {
+		if err := t.command(cmd); err != nil {
+			return fmt.Errorf("line %d: %v", cmd.line, err)
+		}
+	}
+	return nil
+}
+
+func (t *testRun) command(cmd tstCommand) error {
+	name, args := cmd.words[0], cmd.words[1:]
+	arg := func() (string, error) {
+		if len(args) != 1 {
+			return "", fmt.Errorf("%s takes one argument", name)
+		}
+		return args[0], nil
+	}
+	switch name {
+	case "load":
+		if len(args) > 1 {
+			return fmt.Errorf("load takes one file")
+		}
+		file := t.dir
+		if len(args) == 1 {
+			file = t.path(args[0])
+		}
+		return t.load(file)
+	case "output-file", "compare-to":
+		file, err := arg()
+		if err != nil {
+			return err
+		}
+		if name == "output-file" {
+			t.result.OutputFile = t.path(file)
+		} else {
+			t.result.CompareFile = t.path(file)
+		}
+	case "output-list":
+		t.columns = nil
+		for _, a := range args {
+			c, err := parseColumn(a)
+			if err != nil {
+				return err
+			}
+			t.columns = append(t.columns, c)
+		}
+		t.result.Output = append(t.result.Output, t.header())
+	case "output":
+		if len(args) > 0 {
+			return fmt.Errorf("output takes no arguments")
+		}
+		return t.output()
+	case "set":
+		if len(args) != 2 {
+			return fmt.Errorf("set takes a variable and a value")
+		}
+		v, err := parseTestValue(args[1])
+		if err != nil {
+			return err
+		}
+		return t.set(args[0], v)
+	case "ticktock", "tick", "tock":
+		// the CPU runs an instruction a cycle; tick alone does it, tock finishes the cycle
+		if len(args) > 0 {
+			return fmt.Errorf("%s takes no arguments", name)
+		}
+		if name != "tock" {
+			t.m.Step()
+		}
+	case "vmstep":
+		if t.vm == nil {
+			return fmt.Errorf("vmstep needs VM code: load a .vm file or directory")
+		}
+		return t.vm.Step()
+	case "repeat":
+		n, err := arg()
+		if err != nil {
+			return fmt.Errorf("repeat takes a count")
+		}
+		count, err := strconv.Atoi(n)
+		if err != nil || count < 0 {
+			return fmt.Errorf("repeat %s: want a count", n)
+		}
+		for i := 0; i < count; i++ {
+			if err := t.exec(cmd.body); err != nil {
+				return err
+			}
+		}
+	case "while":
+		for {
+			ok, err := t.condition(args)
+			if err != nil || !ok {
+				return err
+			}
+			if err := t.exec(cmd.body); err != nil {
+				return err
+			}
+		}
+	case "echo":
+		text, err := arg()
+		if err != nil {
+			return err
+		}
+		t.result.Echo = append(t.result.Echo, strings.Trim(text, `"`))
+	case "clear-echo", "breakpoint", "clear-breakpoints":
+		// for the course's GUI, which stops the script at breakpoints
+	default:
+		return fmt.Errorf("unknown command %s", name)
+	}
+	return nil
+}
+
+// path finds a file named in the script, relative to the script's directory
+func (t *testRun) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(t.dir, name)
+}
+
+// load puts a program in the CPU's ROM, or VM code in the VM
+func (t *testRun) load(file string) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	switch {
+	case info.IsDir() || strings.HasSuffix(file, ".vm"):
+		t.vm = NewVM(t.m)
+		return t.vm.LoadVM(file)
+	case strings.HasSuffix(file, ".asm"):
+		code, _, err := AssembleFile(file)
+		if err != nil {
+			return err
+		}
+		return t.m.Load(code)
+	}
+	return t.m.LoadFile(file)
+}
+
+// parseColumn reads an output-list entry: a variable with an optional %F(left).(width).(right)
+// format, where F is D for decimal, X for hex, B for binary or S for a string
+func parseColumn(s string) (column, error) {
+	name, format, found := strings.Cut(s, "%")
+	c := column{name: name, format: 'D', left: 1, width: 6, right: 1}
+	if !found {
+		return c, nil
+	}
+	if len(format) == 0 || strings.IndexByte("DXBS", format[0]) < 0 {
+		return c, fmt.Errorf("bad format %%%s for %s: want %%D1.6.1 or similar", format, name)
+	}
+	sizes := strings.Split(format[1:], ".")
+	if len(sizes) != 3 {
+		return c, fmt.Errorf("bad format %%%s for %s: want %%D1.6.1 or similar", format, name)
+	}
+	c.format = format[0]
+	for i, p := range []*int{&c.left, &c.width, &c.right} {
+		n, err := strconv.Atoi(sizes[i])
+		if err != nil || n < 0 {
+			return c, fmt.Errorf("bad format %%%s for %s", format, name)
+		}
+		*p = n
+	}
+	return c, nil
+}
+
+// header names the columns, each centered in its width
+func (t *testRun) header() string {
+	var b strings.Builder
+	b.WriteString("|")
+	for _, c := range t.columns {
+		space := c.left + c.width + c.right
+		name := c.name
+		if len(name) > space {
+			name = name[:space]
+		}
+		left := (space - len(name)) / 2
+		b.WriteString(strings.Repeat(" ", left) + name + strings.Repeat(" ", space-left-len(name)) + "|")
+	}
+	return b.String()
+}
+
+// output writes a row of the output-list variables
+func (t *testRun) output() error {
+	var b strings.Builder
+	b.WriteString("|")
+	for _, c := range t.columns {
+		v, err := t.value(c.name)
+		if err != nil {
+			return err
+		}
+		var text string
+		switch c.format {
+		case 'X':
+			text = fmt.Sprintf("%04X", v)
+		case 'B':
+			text = fmt.Sprintf("%016b", v)
+		default:
+			text = strconv.Itoa(int(int16(v)))
+		}
+		if len(text) > c.width {
+			text = text[len(text)-c.width:]
+		}
+		fmt.Fprintf(&b, "%s%*s%s|", strings.Repeat(" ", c.left), c.width, text, strings.Repeat(" ", c.right))
+	}
+	t.result.Output = append(t.result.Output, b.String())
+	return nil
+}
+
+// value reads a script variable: a VM variable when VM code is loaded, or A, D, PC, time,
+// RAM[i] or ROM[i]
+func (t *testRun) value(name string) (uint16, error) {
+	if t.vm != nil {
+		if v, ok := t.vm.Value(name); ok {
+			return v, nil
+		}
+	}
+	switch name {
+	case "A":
+		return t.m.A, nil
+	case "D":
+		return t.m.D, nil
+	case "PC":
+		return t.m.PC, nil
+	case "time":
+		return uint16(t.m.Cycles), nil
+	}
+	mem, addr, err := t.memory(name)
+	if err != nil {
+		return 0, err
+	}
+	return mem[addr], nil
+}
+
+// set writes a script variable
+func (t *testRun) set(name string, v uint16) error {
+	if t.vm != nil && t.vm.Set(name, v) {
+		return nil
+	}
+	switch name {
+	case "A":
+		t.m.A = v
+	case "D":
+		t.m.D = v
+	case "PC":
+		t.m.PC = v & 0x7FFF
+	default:
+		mem, addr, err := t.memory(name)
+		if err != nil {
+			return err
+		}
+		mem[addr] = v
+		if strings.HasPrefix(name, "ROM[") {
+			t.m.ROMChanged()
+		}
+	}
+	return nil
+}
+
+// memory finds the memory and address of RAM[i] or ROM[i]
+func (t *testRun) memory(name string) ([]uint16, int, error) {
+	var mem []uint16
+	switch {
+	case strings.HasPrefix(name, "RAM["):
+		mem = t.m.RAM[:]
+	case strings.HasPrefix(name, "ROM["):
+		mem = t.m.ROM[:]
+	default:
+		return nil, 0, fmt.Errorf("unknown variable %s", name)
+	}
+	addr, err := strconv.Atoi(strings.TrimSuffix(name[4:], "]"))
+	if !strings.HasSuffix(name, "]") || err != nil || addr < 0 || addr >= len(mem) {
+		return nil, 0, fmt.Errorf("bad address in %s", name)
+	}
+	return mem, addr, nil
+}
+
+// parseTestValue reads a number, in decimal or with a %D, %X or %B prefix
+func parseTestValue(s string) (uint16, error) {
+	base := 10
+	if len(s) > 2 && s[0] == '%' {
+		switch s[1] {
+		case 'X':
+			base = 16
+		case 'B':
+			base = 2
+		case 'D':
+		default:
+			return 0, fmt.Errorf("bad value %s", s)
+		}
+		s = s[2:]
+	}
+	n, err := strconv.ParseInt(s, base, 32)
+	if err != nil || n < -32768 || n > 65535 {
+		return 0, fmt.Errorf("bad value %s", s)
+	}
+	return uint16(n), nil
+}
+
+// condition evaluates a while condition: a comparison of two values with =, <>, <, >, <=
+// or >=, where each is a variable or a number
+func (t *testRun) condition(words []string) (bool, error) {
+	if len(words) != 3 {
+		return false, fmt.Errorf("while needs a condition such as RAM[0] <> 0")
+	}
+	operand := func(s string) (int, error) {
+		if v, err := parseTestValue(s); err == nil {
+			return int(int16(v)), nil
+		}
+		v, err := t.value(s)
+		return int(int16(v)), err
+	}
+	x, err := operand(words[0])
+	if err != nil {
+		return false, err
+	}
+	y, err := operand(words[2])
+	if err != nil {
+		return false, err
+	}
+	switch words[1] {
+	case "=":
+		return x == y, nil
+	case "<>":
+		return x != y, nil
+	case "<":
+		return x < y, nil
+	case ">":
+		return x > y, nil
+	case "<=":
+		return x <= y, nil
+	case ">=":
+		return x >= y, nil
+	}
+	return false, fmt.Errorf("unknown comparison %s", words[1])
+}
+
+// compare checks the output against the compare file, line by line. A * in the compare
+// file matches any character.
+func (t *testRun) compare() error {
+	data, err := os.ReadFile(t.result.CompareFile)
+	if err != nil {
+		return err
+	}
+	want := strings.Split(strings.TrimRight(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n"), "\n")
+	got := t.result.Output
+	for i := 0; i < len(got) || i < len(want); i++ {
+		g, w := "", ""
+		if i < len(got) {
+			g = strings.TrimRight(got[i], " \t")
+		}
+		if i < len(want) {
+			w = strings.TrimRight(want[i], " \t")
+		}
+		if !matchRow(g, w) {
+			t.result.Mismatches = append(t.result.Mismatches, Mismatch{Line: i + 1, Got: g, Want: w})
+		}
+	}
+	return nil
+}
+
+func matchRow(got, want string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := 0; i < len(got); i++ {
+		if got[i] != want[i] && want[i] != '*' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,335 @@
+package emu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VM runs VM code one command at a time, like the course's VM emulator, keeping the stack,
+// segments and pointers in a machine's RAM where the 08 translator's code keeps them. The
+// course's *VME.tst scripts step it with vmstep.
+type VM struct {
+	m         *Machine
+	code      []vmCommand
+	functions map[string]int // command index of each function
+	statics   map[string]int // RAM address of each File.i
+	PC        int            // index of the next command
+}
+
+// vmCommand is one command of a .vm file
+type vmCommand struct {
+	op       string
+	arg      string
+	n        int
+	function string // the function it is in, which scopes labels
+	file     string
+	line     int
+}
+
+// Segments with a base pointer in RAM, and the fixed segments
+var (
+	vmPointers = map[string]int{"local": 1, "argument": 2, "this": 3, "that": 4}
+	vmFixed    = map[string]int{"pointer": 3, "temp": 5}
+)
+
+// NewVM creates a VM working on a machine's RAM
+func NewVM(m *Machine) *VM {
+	return &VM{m: m, functions: map[string]int{}, statics: map[string]int{}}
+}
+
+// LoadVM reads a .vm file, or every .vm file in a directory. It starts at Sys.init when
+// there is one, and at the first command otherwise.
+func (vm *VM) LoadVM(name string) error {
+	files := []string{name}
+	if info, err := os.Stat(name); err != nil {
+		return err
+	} else if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(name, "*.vm")); err != nil {
+			return err
+		}
+		sort.Strings(files)
+		if len(files) == 0 {
+			return fmt.Errorf("%s: no .vm files", name)
+		}
+	}
+	vm.code, vm.functions, vm.statics = nil, map[string]int{}, map[string]int{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		err = vm.read(f, strings.TrimSuffix(filepath.Base(file), ".vm"))
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+	}
+	return vm.link()
+}
+
+// read parses the commands of one file
+func (vm *VM) read(r io.Reader, file string) error {
+	scanner := bufio.NewScanner(r)
+	line, function := 0, ""
+	for scanner.Scan() {
+		line++
+		text, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		c := vmCommand{op: fields[0], function: function, file: file, line: line}
+		want := 0
+		switch c.op {
+		case "add", "sub", "neg", "eq", "gt", "lt", "and", "or", "not", "return":
+		case "label", "goto", "if-goto":
+			want = 1
+		case "push", "pop", "function", "call":
+			want = 2
+		default:
+			return fmt.Errorf("line %d: unknown command %s", line, c.op)
+		}
+		if len(fields) != want+1 {
+			return fmt.Errorf("line %d: %s takes %d arguments", line, c.op, want)
+		}
+		if want > 0 {
+			c.arg = fields[1]
+		}
+		if want == 2 {
+			n, err := strconv.ParseUint(fields[2], 10, 15)
+			if err != nil {
+				return fmt.Errorf("line %d: %q is not a number", line, fields[2])
+			}
+			c.n = int(n)
+			if err := vm.checkSegment(&c); err != nil {
+				return fmt.Errorf("line %d: %v", line, err)
+			}
+		}
+		if c.op == "function" {
+			function, c.function = c.arg, c.arg
+			if _, ok := vm.functions[c.arg]; ok {
+				return fmt.Errorf("line %d: function %s is defined twice", line, c.arg)
+			}
+			vm.functions[c.arg] = len(vm.code)
+		}
+		vm.code = append(vm.code, c)
+	}
+	return scanner.Err()
+}
+
+// checkSegment checks the segment of a push or pop, and gives each static variable an
+// address as the assembler would, in order of first use from 16
+func (vm *VM) checkSegment(c *vmCommand) error {
+	if c.op != "push" && c.op != "pop" {
+		return nil
+	}
+	switch c.arg {
+	case "constant":
+		if c.op == "pop" {
+			return fmt.Errorf("cannot pop to constant")
+		}
+	case "static":
+		name := fmt.Sprintf("%s.%d", c.file, c.n)
+		if _, ok := vm.statics[name]; !ok {
+			vm.statics[name] = 16 + len(vm.statics)
+		}
+	case "pointer", "temp":
+		if c.arg == "pointer" && c.n > 1 || c.arg == "temp" && c.n > 7 {
+			return fmt.Errorf("%s %d is out of range", c.arg, c.n)
+		}
+	default:
+		if _, ok := vmPointers[c.arg]; !ok {
+			return fmt.Errorf("unknown segment %s", c.arg)
+		}
+	}
+	return nil
+}
+
+// link checks that every call and jump has somewhere to go, and finds the first command
+func (vm *VM) link() error {
+	for _, c := range vm.code {
+		switch c.op {
+		case "call":
+			if _, ok := vm.functions[c.arg]; !ok {
+				return fmt.Errorf("%s.vm line %d: no function %s", c.file, c.line, c.arg)
+			}
+		case "goto", "if-goto":
+			if _, ok := vm.label(c.function, c.arg); !ok {
+				return fmt.Errorf("%s.vm line %d: no label %s in %s", c.file, c.line, c.arg, c.function)
+			}
+		}
+	}
+	vm.PC = 0
+	if start, ok := vm.functions["Sys.init"]; ok {
+		vm.PC = start
+	}
+	return nil
+}
+
+// label finds a label within a function
+func (vm *VM) label(function, name string) (int, bool) {
+	for i, c := range vm.code {
+		if c.op == "label" && c.arg == name && c.function == function {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (vm *VM) push(v uint16) {
+	sp := vm.m.RAM[0]
+	vm.m.RAM[sp&0x7FFF] = v
+	vm.m.RAM[0] = sp + 1
+}
+
+func (vm *VM) pop() uint16 {
+	vm.m.RAM[0]--
+	return vm.m.RAM[vm.m.RAM[0]&0x7FFF]
+}
+
+// address returns the RAM address of a segment entry
+func (vm *VM) address(c *vmCommand) uint16 {
+	if p, ok := vmPointers[c.arg]; ok {
+		return (vm.m.RAM[p] + uint16(c.n)) & 0x7FFF
+	}
+	if c.arg == "static" {
+		return uint16(vm.statics[fmt.Sprintf("%s.%d", c.file, c.n)])
+	}
+	return uint16(vmFixed[c.arg] + c.n)
+}
+
+// Step executes one VM command
+func (vm *VM) Step() error {
+	if vm.PC >= len(vm.code) {
+		return fmt.Errorf("ran past the last VM command")
+	}
+	c := &vm.code[vm.PC]
+	vm.PC++
+	ram := &vm.m.RAM
+	truth := func(b bool) uint16 {
+		if b {
+			return 0xFFFF
+		}
+		return 0
+	}
+	switch c.op {
+	case "push":
+		if c.arg == "constant" {
+			vm.push(uint16(c.n))
+		} else {
+			vm.push(ram[vm.address(c)])
+		}
+	case "pop":
+		addr := vm.address(c)
+		ram[addr] = vm.pop()
+	case "neg":
+		vm.push(-vm.pop())
+	case "not":
+		vm.push(^vm.pop())
+	case "add", "sub", "eq", "gt", "lt", "and", "or":
+		y, x := vm.pop(), vm.pop()
+		switch c.op {
+		case "add":
+			vm.push(x + y)
+		case "sub":
+			vm.push(x - y)
+		case "eq":
+			vm.push(truth(x == y))
+		case "gt":
+			vm.push(truth(int16(x) > int16(y)))
+		case "lt":
+			vm.push(truth(int16(x) < int16(y)))
+		case "and":
+			vm.push(x & y)
+		case "or":
+			vm.push(x | y)
+		}
+	case "label":
+	case "goto":
+		vm.PC, _ = vm.label(c.function, c.arg)
+	case "if-goto":
+		if vm.pop() != 0 {
+			vm.PC, _ = vm.label(c.function, c.arg)
+		}
+	case "function":
+		for i := 0; i < c.n; i++ {
+			vm.push(0)
+		}
+	case "call":
+		// the frame holds the index of the command to return to where the CPU would have
+		// a ROM address
+		vm.push(uint16(vm.PC))
+		for p := 1; p <= 4; p++ {
+			vm.push(ram[p])
+		}
+		ram[2] = ram[0] - 5 - uint16(c.n)
+		ram[1] = ram[0]
+		vm.PC = vm.functions[c.arg]
+	case "return":
+		frame := ram[1]
+		ret := ram[(frame-5)&0x7FFF]
+		ram[ram[2]&0x7FFF] = vm.pop()
+		ram[0] = ram[2] + 1
+		for p := 4; p >= 1; p-- {
+			ram[p] = ram[(frame-uint16(5-p))&0x7FFF]
+		}
+		vm.PC = int(ret)
+	}
+	return nil
+}
+
+// Value reads a VM emulator variable: sp, local, argument, this or that for a pointer, or
+// local[i], argument[i], this[i], that[i], temp[i], pointer[i] or static[i] for a segment
+// entry, where static is that of the file running
+func (vm *VM) Value(name string) (uint16, bool) {
+	addr, ok := vm.variable(name)
+	if !ok {
+		return 0, false
+	}
+	return vm.m.RAM[addr], true
+}
+
+// Set writes a VM emulator variable
+func (vm *VM) Set(name string, v uint16) bool {
+	addr, ok := vm.variable(name)
+	if ok {
+		vm.m.RAM[addr] = v
+	}
+	return ok
+}
+
+// variable returns the RAM address of a VM emulator variable
+func (vm *VM) variable(name string) (uint16, bool) {
+	if name == "sp" {
+		return 0, true
+	}
+	if p, ok := vmPointers[name]; ok {
+		return uint16(p), true
+	}
+	segment, index, ok := strings.Cut(name, "[")
+	if !ok || !strings.HasSuffix(index, "]") {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(strings.TrimSuffix(index, "]"), 10, 15)
+	if err != nil {
+		return 0, false
+	}
+	c := vmCommand{op: "push", arg: segment, n: int(n)}
+	if vm.PC < len(vm.code) {
+		c.file = vm.code[vm.PC].file
+	}
+	if segment == "static" {
+		if _, ok := vm.statics[fmt.Sprintf("%s.%d", c.file, c.n)]; !ok {
+			return 0, false
+		}
+	} else if _, ok := vmPointers[segment]; !ok && segment != "temp" && segment != "pointer" {
+		return 0, false
+	}
+	return vm.address(&c), true
+}
@@ -0,0 +1,13 @@
+module hack-emulator
+
+go 1.20
+
+require (
+	diagnostic v0.0.0
+	hack-assembler v0.0.0
+)
+
+replace (
+	diagnostic => ../../diagnostic
+	hack-assembler => ../../06/assembler
+)
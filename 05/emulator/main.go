@@ -0,0 +1,124 @@
+// emulator runs .hack programs written by the 06 assembler on an emulated Hack computer.
+//
+//	emulator run [--cycles N] [--until COND] [--halt-at LABELS] [--set TARGET=VALUE] [--expect NAME=VALUE] [--ram FROM-TO] file.hack|file.asm
+//	emulator debug [--sym file.sym] file.hack
+//	emulator trace [--sym file.sym] [--rom ranges] [--ram ranges] file.trace
+//	emulator test [--no-out] script.tst ...
+//	emulator diff [--sym file.sym] [--max N] old.snap new.snap
+//	emulator play [--speed N] [--blocks] file.hack|file.asm
+//
+// Flags may come before or after the files. Exit status is 0 on success, 1 if the program did not do what was asked of it, 2 on
+// trouble, such as a file that cannot be loaded, and 3 if run stopped at its cycle limit.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"hack-emulator/emu"
+)
+
+// command is a subcommand of the emulator
+type command struct {
+	usage string
+	run   func(args []string) int
+}
+
+var commands = map[string]command{
+	"run":   {"run a program and print the registers and RAM", runCommand},
+	"debug": {"step through a program, with breakpoints and RAM inspection", debugCommand},
+	"trace": {"print a trace recorded by run --trace as text", traceCommand},
+	"test":  {"run the course's .tst scripts and check their output against the .cmp files", testCommand},
+	"diff":  {"compare two snapshots saved by run --save-snapshot, region by region", diffCommand},
+	"play":  {"run a program in real time on the terminal, with its screen and the keyboard", playCommand},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+	}
+	os.Exit(cmd.run(os.Args[2:]))
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: emulator <command> [flags] file.hack")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", name, commands[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "Run \"emulator <command> -h\" for the command's flags.")
+	os.Exit(2)
+}
+
+// parseArgs parses a subcommand's flags, which may come before or after its files, and
+// returns the files
+func parseArgs(flags *flag.FlagSet, args []string) []string {
+	files := []string{}
+	for {
+		flags.Parse(args)
+		args = flags.Args()
+		if len(args) == 0 {
+			return files
+		}
+		files, args = append(files, args[0]), args[1:]
+	}
+}
+
+// fail reports trouble and returns the exit status for it
+func fail(err error) int {
+	fmt.Fprintln(os.Stderr, "emulator:", err)
+	return 2
+}
+
+// load reads a program, and the symbols from symFile or, if that is empty, from the .sym file
+// next to the program when there is one. A .asm file is assembled in memory, and brings its
+// own symbols.
+func load(filename, symFile string) (*emu.Machine, *emu.Symbols, error) {
+	m := emu.New()
+	if strings.HasSuffix(filename, ".asm") {
+		code, syms, err := emu.AssembleFile(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		return m, syms, m.Load(code)
+	}
+	if err := m.LoadFile(filename); err != nil {
+		return nil, nil, err
+	}
+	syms := emu.NewSymbols()
+	if symFile == "" {
+		symFile = strings.TrimSuffix(filename, ".hack") + ".sym"
+		if _, err := os.Stat(symFile); err != nil {
+			return m, syms, nil
+		}
+	}
+	if err := syms.ReadSymbols(symFile); err != nil {
+		return nil, nil, err
+	}
+	return m, syms, nil
+}
+
+// writeFile creates a file and fills it with write
+func writeFile(name string, write func(io.Writer) error) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
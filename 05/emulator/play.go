@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"hack-assembler/asm"
+	"hack-emulator/emu"
+)
+
+func playCommand(args []string) int {
+	flags := flag.NewFlagSet("play", flag.ExitOnError)
+	symFile := flags.String("sym", "", "symbol file from the assembler's --sym flag (default: the .sym file next to the program)")
+	speed := flags.Float64("speed", 5, "run `N` million instructions a second, or 0 for as fast as possible; ^F and ^B double and halve it")
+	hold := flags.Duration("hold", 200*time.Millisecond, "how long a key stays in KBD after each press or autorepeat, as terminals do not report releases")
+	fps := flags.Int("fps", 20, "redraw the screen at most `N` times a second")
+	blocks := flags.Bool("blocks", false, "draw with half blocks, 1×2 pixels a character, instead of braille, 2×4")
+	haltAt := flags.String("halt-at", "", "stop when the PC reaches one of these `labels` or addresses, comma-separated, such as Sys.halt")
+	record := flags.String("record-keys", "", "write the key presses and releases to a script `file` for run --keys")
+	files := parseArgs(flags, args)
+	if len(files) != 1 || *fps < 1 || *speed < 0 {
+		fmt.Fprintln(os.Stderr, "usage: emulator play [--speed N] [--hold duration] [--fps N] [--blocks] [--halt-at labels] [--record-keys script] [--sym file.sym] file.hack|file.asm")
+		return 2
+	}
+	m, syms, err := load(files[0], *symFile)
+	if err != nil {
+		return fail(err)
+	}
+	haltAddrs, err := parseAddresses(*haltAt, syms)
+	if err != nil {
+		return fail(fmt.Errorf("--halt-at %q: %v", *haltAt, err))
+	}
+	p := &player{
+		m: m, syms: syms, name: files[0], speed: *speed, hold: *hold, braille: !*blocks,
+		halts:    emu.Halts{Loops: true},
+		recorder: &emu.KeyRecorder{},
+		out:      bufio.NewWriterSize(os.Stdout, 1<<16),
+	}
+	for addr := range haltAddrs {
+		p.halts.At = append(p.halts.At, addr)
+	}
+
+	restore, err := rawTerminal(int(os.Stdin.Fd()))
+	if err != nil {
+		return fail(err)
+	}
+	p.play(time.Second / time.Duration(*fps))
+	restore()
+	if *record != "" {
+		if err := writeFile(*record, p.recorder.Script().Write); err != nil {
+			return fail(err)
+		}
+	}
+	return 0
+}
+
+// player runs a program in real time on the terminal, drawing its screen and feeding it keys
+type player struct {
+	m        *emu.Machine
+	syms     *emu.Symbols
+	name     string
+	speed    float64 // millions of instructions a second, or 0 for no limit
+	hold     time.Duration
+	braille  bool
+	halts    emu.Halts
+	recorder *emu.KeyRecorder
+	out      *bufio.Writer
+
+	release time.Time // when the key held in KBD is let go
+	paused  bool
+	halted  bool
+	due     float64 // instructions owed to real time
+
+	pending   []byte // the start of an escape sequence, until the rest is read
+	pendingAt time.Time
+
+	lines      []string // the screen as last drawn
+	cols, rows int
+	drawn      uint64 // ScreenChanged when the screen was last drawn
+	rate       float64
+	rateCycles uint64
+	rateTime   time.Time
+}
+
+// play runs until the user quits, drawing a frame each tick
+func (p *player) play(frame time.Duration) {
+	// keys come in chunks, as the terminal sends them, and an escape sequence may be split
+	// across two
+	input := make(chan []byte)
+	go func() {
+		for {
+			buf := make([]byte, 256)
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				close(input)
+				return
+			}
+			input <- buf[:n]
+		}
+	}()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	// the alternate screen keeps the shell's scrollback, and the cursor is hidden
+	p.out.WriteString("\x1b[?1049h\x1b[?25l")
+	defer func() {
+		p.out.WriteString("\x1b[0m\x1b[?25h\x1b[?1049l")
+		p.out.Flush()
+	}()
+
+	ticker := time.NewTicker(frame)
+	defer ticker.Stop()
+	last := time.Now()
+	p.rateTime = last
+	p.draw()
+	for {
+		select {
+		case in, ok := <-input:
+			if !ok || p.keys(in, time.Now(), false) {
+				return
+			}
+		case <-signals:
+			return
+		case now := <-ticker.C:
+			// an escape with nothing after it for a frame is the Esc key
+			if len(p.pending) > 0 && now.Sub(p.pendingAt) >= frame && p.keys(nil, now, true) {
+				return
+			}
+			p.run(now, now.Sub(last), frame)
+			last = now
+			p.draw()
+		}
+	}
+}
+
+// keys handles the keys in a chunk of input, and reports whether the user quit. An escape
+// sequence cut short at the end waits in pending for the rest, unless final says that none
+// is coming.
+func (p *player) keys(in []byte, now time.Time, final bool) bool {
+	if len(p.pending) > 0 {
+		in = append(p.pending, in...)
+		p.pending = nil
+	}
+	for len(in) > 0 {
+		n := 1
+		switch in[0] {
+		case 'C' & 0x1F, 'Q' & 0x1F:
+			return true
+		case 'F' & 0x1F:
+			p.speed *= 2
+		case 'B' & 0x1F:
+			if p.speed == 0 {
+				// leave max at the rate it reached
+				p.speed = p.rate / 1e6
+				if p.speed == 0 {
+					p.speed = 1
+				}
+			}
+			p.speed /= 2
+		case 'P' & 0x1F:
+			p.paused = !p.paused
+		default:
+			var code uint16
+			if code, n = emu.TerminalKey(in, final); n == 0 {
+				p.pending, p.pendingAt = append([]byte(nil), in...), now
+				return false
+			}
+			if code != 0 {
+				p.m.SetKey(code)
+				p.recorder.Record(p.m)
+				p.release = now.Add(p.hold)
+			}
+		}
+		in = in[n:]
+	}
+	return false
+}
+
+// run executes the instructions due in the time since the last tick, and lets go of the key
+// once its hold is over
+func (p *player) run(now time.Time, elapsed, frame time.Duration) {
+	if p.m.RAM[emu.KBD] != 0 && now.After(p.release) {
+		p.m.SetKey(0)
+		p.recorder.Record(p.m)
+	}
+	if now.Sub(p.rateTime) >= time.Second {
+		p.rate = float64(p.m.Cycles-p.rateCycles) / now.Sub(p.rateTime).Seconds()
+		p.rateCycles, p.rateTime = p.m.Cycles, now
+	}
+	if p.paused || p.halted {
+		return
+	}
+	if p.speed == 0 {
+		// as fast as possible, leaving a fifth of the frame for drawing and keys
+		for start := time.Now(); time.Since(start) < frame*4/5 && !p.halted; {
+			p.halted = p.m.RunToHalt(1<<20, p.halts)
+		}
+		return
+	}
+	// a program that falls behind, such as after a pause of the terminal, does not race to
+	// catch up
+	perSecond := p.speed * 1e6
+	p.due += perSecond * elapsed.Seconds()
+	if p.due > perSecond/4 {
+		p.due = perSecond / 4
+	}
+	n := uint64(p.due)
+	p.due -= float64(n)
+	p.halted = p.m.RunToHalt(n, p.halts)
+}
+
+// draw redraws the lines of the screen that changed, and the status line below them
+func (p *player) draw() {
+	cols, rows, err := terminalSize(int(os.Stdout.Fd()))
+	if err != nil {
+		cols, rows = 80, 24
+	}
+	if cols != p.cols || rows != p.rows {
+		p.cols, p.rows, p.lines = cols, rows, nil
+		p.out.WriteString("\x1b[0m\x1b[2J")
+	}
+	if p.lines == nil || p.m.ScreenChanged != p.drawn {
+		lines := p.m.TextScreen(cols, rows-1, p.braille)
+		left := 0
+		if len(lines) > 0 {
+			left = (cols - utf8.RuneCountInString(lines[0])) / 2
+		}
+		for i, line := range lines {
+			if i < len(p.lines) && p.lines[i] == line {
+				continue
+			}
+			// black pixels on white, whatever the terminal's colors
+			fmt.Fprintf(p.out, "\x1b[%d;%dH\x1b[30;47m%s\x1b[0m", i+1, left+1, line)
+		}
+		p.lines, p.drawn = lines, p.m.ScreenChanged
+	}
+	fmt.Fprintf(p.out, "\x1b[%d;1H\x1b[7m%s\x1b[0m", rows, fit(p.status(), cols))
+	p.out.Flush()
+}
+
+// status describes the run for the bottom line of the terminal
+func (p *player) status() string {
+	state := "running"
+	switch {
+	case p.halted:
+		state = "halted at " + p.syms.Locate(int(p.m.PC))
+	case p.paused:
+		state = "paused"
+	}
+	speed := "max"
+	if p.speed > 0 {
+		speed = fmt.Sprintf("%.3gM", p.speed)
+	}
+	return fmt.Sprintf(" %s  %s  %.3gM/s of %s  cycle %d  key %s  ^F faster ^B slower ^P pause ^C quit",
+		p.name, state, p.rate/1e6, speed, p.m.Cycles, keyText(p.m.RAM[emu.KBD]))
+}
+
+// keyText names a key code for the status line
+func keyText(code uint16) string {
+	switch {
+	case code == 0:
+		return "-"
+	case code > ' ' && code <= '~':
+		return string(rune(code))
+	case code == ' ':
+		return "space"
+	}
+	if name, ok := asm.KeyName(int(code)); ok {
+		return strings.TrimPrefix(name, "KEY_")
+	}
+	return fmt.Sprint(code)
+}
+
+// fit pads or cuts a line of ASCII to a width
+func fit(s string, width int) string {
+	if len(s) > width {
+		return s[:width]
+	}
+	return s + strings.Repeat(" ", width-len(s))
+}
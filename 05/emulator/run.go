@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"hack-emulator/emu"
+)
+
+func runCommand(args []string) int {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	var cycles uint64
+	flags.Uint64Var(&cycles, "cycles", 1000000, "the most instructions to run; reaching it exits with status 3")
+	flags.Uint64Var(&cycles, "max-cycles", 1000000, "the same as --cycles")
+	haltLoop := flags.Bool("halt-loop", true, "stop when the program reaches a loop that can change nothing, such as (END) @END 0;JMP")
+	haltAt := flags.String("halt-at", "", "also stop when the PC reaches one of these `labels` or addresses, comma-separated, such as Sys.halt")
+	var cases caseList
+	flags.Var(caseFlag{&cases, true}, "set", "set a register or RAM cell before the run, as `TARGET=VALUE` such as R0=3 (repeatable)")
+	flags.Var(caseFlag{&cases, false}, "expect", "check a value after the run, as `NAME=VALUE` such as R2=15 or a condition (repeatable); a --set after an --expect starts a new case")
+	until := flags.String("until", "", "stop as soon as `condition` holds, e.g. 'PC == END' or 'R2 != 0'")
+	symFile := flags.String("sym", "", "symbol file from the assembler's --sym flag (default: the .sym file next to the program)")
+	ram := flags.String("ram", "0-15", "RAM `range` to print after the run, as FROM-TO or a single address")
+	pngFile := flags.String("png", "", "write the screen to a PNG `file` when the run stops")
+	golden := flags.String("golden", "", "compare the screen when the run stops with a PNG `file`, and fail if any pixel differs")
+	keys := flags.String("keys", "", "play a keyboard script `file` into KBD")
+	record := flags.String("record-keys", "", "write the key presses and releases of the run to a script `file`, with the cycle of each")
+	traceFile := flags.String("trace", "", "record each instruction, the registers and RAM writes to a binary trace `file`; see the trace command")
+	traceROM := flags.String("trace-rom", "", "trace only instructions in these ROM `ranges`: addresses, labels or FROM-TO, comma-separated")
+	traceRAM := flags.String("trace-ram", "", "trace only instructions writing to these RAM `ranges`: addresses, symbols, FROM-TO or regions such as stack")
+	traceMax := flags.String("trace-max", "100M", "stop tracing when the trace reaches this `size` in bytes, with an optional K, M or G")
+	profFile := flags.String("profile", "", "write a report of the instructions executed by VM function, label and address to a `file`, or - for standard output")
+	profTop := flags.Int("profile-top", 20, "list the `N` most executed addresses in the --profile report (0 for all)")
+	pprofFile := flags.String("pprof", "", "write the instruction counts to a `file` for go tool pprof")
+	fromSnap := flags.String("from-snapshot", "", "start from the state saved in a snapshot `file` of the same program")
+	saveSnap := flags.String("save-snapshot", "", "save RAM, the registers and the cycle count to a snapshot `file` when the run stops")
+	files := parseArgs(flags, args)
+	if len(files) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: emulator run [--cycles N] [--until condition] [--halt-at labels] [--halt-loop=false] [--set TARGET=VALUE ...] [--expect NAME=VALUE ...] [--sym file.sym] [--ram FROM-TO] [--png file.png] [--golden file.png] [--keys script] [--record-keys script] [--trace file] [--trace-rom ranges] [--trace-ram ranges] [--trace-max size] [--profile file] [--profile-top N] [--pprof file] [--from-snapshot file] [--save-snapshot file] file.hack|file.asm")
+		return 2
+	}
+	from, to, err := parseRange(*ram)
+	if err != nil {
+		return fail(err)
+	}
+	m, syms, err := load(files[0], *symFile)
+	if err != nil {
+		return fail(err)
+	}
+	if *fromSnap != "" {
+		s, err := emu.LoadSnapshot(*fromSnap)
+		if err != nil {
+			return fail(err)
+		}
+		if err := m.Restore(s); err != nil {
+			return fail(fmt.Errorf("%s: %v", *fromSnap, err))
+		}
+	}
+
+	// stop checks for the end of the run after each instruction, and gets the keyboard
+	// ready for the next one
+	var cond *emu.Expr
+	if *until != "" {
+		if cond, err = emu.ParseExpr(*until, syms); err != nil {
+			return fail(fmt.Errorf("--until %q: %v", *until, err))
+		}
+	}
+	haltAddrs, err := parseAddresses(*haltAt, syms)
+	if err != nil {
+		return fail(fmt.Errorf("--halt-at %q: %v", *haltAt, err))
+	}
+	halted := func(m *emu.Machine) bool {
+		return haltAddrs[m.PC] || *haltLoop && m.Halted()
+	}
+	var script *emu.KeyScript
+	if *keys != "" {
+		if script, err = emu.LoadKeyScript(*keys); err != nil {
+			return fail(err)
+		}
+	}
+	var keyboard *emu.Keyboard
+	recorder := &emu.KeyRecorder{}
+	stop := func(m *emu.Machine) bool {
+		if cond != nil && cond.True(m) {
+			return true
+		}
+		if keyboard != nil {
+			keyboard.Update(m)
+		}
+		recorder.Record(m)
+		return false
+	}
+
+	if len(cases) == 0 {
+		cases = caseList{{}}
+	}
+	if len(cases) > 1 && (*traceFile != "" || *record != "") {
+		return fail(fmt.Errorf("--trace and --record-keys record one run, but --set and --expect give %d cases", len(cases)))
+	}
+	for i := range cases {
+		if err := cases[i].parse(syms); err != nil {
+			return fail(err)
+		}
+	}
+
+	var tracer *emu.Tracer
+	if *traceFile != "" {
+		f, err := os.Create(*traceFile)
+		if err != nil {
+			return fail(err)
+		}
+		defer f.Close()
+		if tracer, err = newTracer(f, syms, *traceROM, *traceRAM, *traceMax); err != nil {
+			return fail(err)
+		}
+	}
+
+	// step executes one instruction, tracing and profiling it as asked
+	step := m.Step
+	if tracer != nil {
+		step = func() { tracer.Step(m) }
+	}
+	var profile *emu.Profile
+	if *profFile != "" || *pprofFile != "" {
+		profile = emu.NewProfile()
+		execute := step
+		step = func() {
+			profile.Count(m)
+			execute()
+		}
+	}
+
+	// with nothing to do between instructions, the fast core runs the program on its own
+	fast := tracer == nil && profile == nil && cond == nil && script == nil && *record == ""
+	halts := emu.Halts{Loops: *haltLoop}
+	for addr := range haltAddrs {
+		halts.At = append(halts.At, addr)
+	}
+
+	// each case starts from the program as loaded, with its own settings
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	status, limited := 0, false
+	loaded := *m
+	expecting := false
+	for i := range cases {
+		c := &cases[i]
+		*m = loaded
+		for _, a := range c.sets {
+			a.Apply(m)
+		}
+		if script != nil {
+			keyboard = emu.NewKeyboard(script)
+		}
+		var met, done bool
+		if fast {
+			done = m.RunToHalt(cycles, halts)
+		} else {
+			met = stop(m)
+			done = met || halted(m)
+			for n := cycles; n > 0 && !done; n-- {
+				step()
+				met = stop(m)
+				done = met || halted(m)
+			}
+		}
+		switch {
+		case met:
+		case done:
+			if cond != nil {
+				fmt.Fprintf(os.Stderr, "emulator: the program halted at %s before %s held\n", syms.Locate(int(m.PC)), *until)
+				status = 1
+			}
+		case cond != nil:
+			fmt.Fprintf(os.Stderr, "emulator: %s did not hold within %d cycles\n", *until, cycles)
+			limited = true
+		default:
+			fmt.Fprintf(os.Stderr, "emulator: the program did not halt within %d cycles\n", cycles)
+			limited = true
+		}
+		if len(c.expects) > 0 {
+			expecting = true
+			if !c.check(out, m) {
+				status = 1
+			}
+		}
+	}
+	if tracer != nil {
+		if err := tracer.Close(); err != nil {
+			return fail(err)
+		}
+		if tracer.Full {
+			fmt.Fprintf(os.Stderr, "emulator: the trace reached its size limit of %s after %d instructions\n", *traceMax, tracer.Records)
+		}
+	}
+	if *record != "" {
+		if err := writeFile(*record, recorder.Script().Write); err != nil {
+			return fail(err)
+		}
+	}
+
+	if profile != nil {
+		if err := writeProfile(out, profile, m, syms, files[0], *profFile, *profTop, *pprofFile); err != nil {
+			return fail(err)
+		}
+	}
+	if *saveSnap != "" {
+		if err := m.Snapshot().Save(*saveSnap); err != nil {
+			return fail(err)
+		}
+	}
+	if *pngFile != "" {
+		if err := m.SaveScreen(*pngFile); err != nil {
+			return fail(err)
+		}
+	}
+	ramFlag := false
+	flags.Visit(func(f *flag.Flag) { ramFlag = ramFlag || f.Name == "ram" })
+	if !expecting || ramFlag {
+		printState(out, m, syms, from, to)
+	}
+	if *golden != "" {
+		want, err := emu.ReadImage(*golden)
+		if err != nil {
+			return fail(err)
+		}
+		n, box, err := emu.PixelDiff(m.ScreenImage(), want)
+		if err != nil {
+			return fail(fmt.Errorf("%s: %v", *golden, err))
+		}
+		if n > 0 {
+			fmt.Fprintf(out, "screen differs from %s in %d pixels, within x %d-%d, y %d-%d\n", *golden, n, box.Min.X, box.Max.X-1, box.Min.Y, box.Max.Y-1)
+			status = 1
+		} else {
+			fmt.Fprintf(out, "screen matches %s\n", *golden)
+		}
+	}
+	if status == 0 && limited {
+		return 3
+	}
+	return status
+}
+
+// parseAddresses reads a comma-separated list of ROM addresses and labels
+func parseAddresses(spec string, syms *emu.Symbols) (map[uint16]bool, error) {
+	addrs := map[uint16]bool{}
+	if spec == "" {
+		return addrs, nil
+	}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		addr, ok := syms.LabelAddress(item)
+		if !ok {
+			n, err := strconv.ParseUint(item, 10, 15)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a label or a ROM address", item)
+			}
+			addr = int(n)
+		}
+		addrs[uint16(addr)] = true
+	}
+	return addrs, nil
+}
+
+// runCase is a run of the program: the assignments before it and the checks after it
+type runCase struct {
+	setText, expectText []string
+	sets                []*emu.Assignment
+	expects             []*emu.Expectation
+}
+
+// caseList holds the cases in the order their flags were given
+type caseList []runCase
+
+// caseFlag adds a --set or --expect to the cases. A --set after an --expect starts a new case.
+type caseFlag struct {
+	cases *caseList
+	set   bool
+}
+
+func (f caseFlag) String() string {
+	return ""
+}
+
+func (f caseFlag) Set(text string) error {
+	cases := f.cases
+	if len(*cases) == 0 || f.set && len((*cases)[len(*cases)-1].expectText) > 0 {
+		*cases = append(*cases, runCase{})
+	}
+	c := &(*cases)[len(*cases)-1]
+	if f.set {
+		c.setText = append(c.setText, text)
+	} else {
+		c.expectText = append(c.expectText, text)
+	}
+	return nil
+}
+
+// parse reads the case's assignments and expectations
+func (c *runCase) parse(syms *emu.Symbols) error {
+	for _, text := range c.setText {
+		a, err := emu.ParseAssignment(text, syms)
+		if err != nil {
+			return fmt.Errorf("--set %q: %v", text, err)
+		}
+		c.sets = append(c.sets, a)
+	}
+	for _, text := range c.expectText {
+		e, err := emu.ParseExpectation(text, syms)
+		if err != nil {
+			return fmt.Errorf("--expect %q: %v", text, err)
+		}
+		c.expects = append(c.expects, e)
+	}
+	return nil
+}
+
+// check reports the case as ok or, with what differs, as FAIL, and returns whether it passed
+func (c *runCase) check(out io.Writer, m *emu.Machine) bool {
+	failures := []string{}
+	for _, e := range c.expects {
+		if err := e.Check(m); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	name := strings.Join(c.setText, " ")
+	if name != "" {
+		name += ": "
+	}
+	if len(failures) > 0 {
+		fmt.Fprintf(out, "FAIL %s%s\n", name, strings.Join(failures, ", "))
+		return false
+	}
+	fmt.Fprintf(out, "ok   %s%s\n", name, strings.Join(c.expectText, " "))
+	return true
+}
+
+// printState writes the registers and a range of RAM
+func printState(out *bufio.Writer, m *emu.Machine, syms *emu.Symbols, from, to int) {
+	fmt.Fprintf(out, "PC=%d A=%d D=%d cycles=%d\n", m.PC, int16(m.A), int16(m.D), m.Cycles)
+	for addr := from; addr <= to; addr++ {
+		fmt.Fprintf(out, "RAM[%d]", addr)
+		if name := syms.RAMName(addr); name != "" {
+			fmt.Fprintf(out, " %s", name)
+		}
+		fmt.Fprintf(out, " = %d\n", int16(m.RAM[addr]))
+	}
+}
+
+// parseRange reads a RAM range written FROM-TO or as one address
+func parseRange(s string) (int, int, error) {
+	first, last, found := strings.Cut(s, "-")
+	if !found {
+		last = first
+	}
+	from, err1 := strconv.Atoi(first)
+	to, err2 := strconv.Atoi(last)
+	if err1 != nil || err2 != nil || from < 0 || to >= emu.RAMSize || from > to {
+		return 0, 0, fmt.Errorf("bad RAM range %q: want FROM-TO, within 0-%d", s, emu.RAMSize-1)
+	}
+	return from, to, nil
+}
+
+// writeProfile writes the profile's report and pprof files, either of which may be "". A report
+// to "-" goes to out, in order with the rest of the run's output.
+func writeProfile(out io.Writer, profile *emu.Profile, m *emu.Machine, syms *emu.Symbols, program, report string, top int, pprof string) error {
+	if pprof != "" {
+		if err := writeFile(pprof, func(w io.Writer) error { return profile.WritePprof(w, syms, program) }); err != nil {
+			return err
+		}
+	}
+	switch report {
+	case "":
+		return nil
+	case "-":
+		return profile.WriteReport(out, m, syms, top)
+	}
+	return writeFile(report, func(w io.Writer) error { return profile.WriteReport(w, m, syms, top) })
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package main
+
+import "errors"
+
+var errTerminal = errors.New("play needs a Linux terminal")
+
+func rawTerminal(fd int) (func(), error) {
+	return nil, errTerminal
+}
+
+func terminalSize(fd int) (int, int, error) {
+	return 0, 0, errTerminal
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"hack-emulator/emu"
+)
+
+func testCommand(args []string) int {
+	flags := flag.NewFlagSet("test", flag.ExitOnError)
+	noOut := flags.Bool("no-out", false, "do not write the scripts' output files")
+	files := parseArgs(flags, args)
+	if len(files) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: emulator test [--no-out] script.tst ...")
+		return 2
+	}
+	status := 0
+	for _, script := range files {
+		r, err := emu.RunTestScript(script)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "emulator:", err)
+			status = 2
+			continue
+		}
+		for _, text := range r.Echo {
+			fmt.Printf("%s: %s\n", script, text)
+		}
+		if r.OutputFile != "" && !*noOut {
+			err := writeFile(r.OutputFile, func(w io.Writer) error {
+				_, err := io.WriteString(w, strings.Join(r.Output, "\n")+"\n")
+				return err
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "emulator:", err)
+				status = 2
+			}
+		}
+		switch {
+		case len(r.Mismatches) > 0:
+			fmt.Printf("FAIL %s: %d lines differ from %s\n", script, len(r.Mismatches), r.CompareFile)
+			for _, m := range r.Mismatches {
+				fmt.Printf("  line %d\n    got  %s\n    want %s\n", m.Line, m.Got, m.Want)
+			}
+			if status == 0 {
+				status = 1
+			}
+		case r.CompareFile == "":
+			fmt.Printf("ran  %s (no compare-to file)\n", script)
+		default:
+			fmt.Printf("ok   %s\n", script)
+		}
+	}
+	return status
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"hack-emulator/emu"
+)
+
+func traceCommand(args []string) int {
+	flags := flag.NewFlagSet("trace", flag.ExitOnError)
+	symFile := flags.String("sym", "", "symbol file for labels and variable names")
+	rom := flags.String("rom", "", "show only instructions in these ROM `ranges`: addresses, labels or FROM-TO, comma-separated")
+	ram := flags.String("ram", "", "show only instructions writing to these RAM `ranges`: addresses, symbols, FROM-TO or regions such as stack")
+	files := parseArgs(flags, args)
+	if len(files) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: emulator trace [--sym file.sym] [--rom ranges] [--ram ranges] file.trace")
+		return 2
+	}
+	syms := emu.NewSymbols()
+	if *symFile != "" {
+		if err := syms.ReadSymbols(*symFile); err != nil {
+			return fail(err)
+		}
+	}
+	filter, err := traceFilter(syms, *rom, *ram)
+	if err != nil {
+		return fail(err)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		return fail(err)
+	}
+	defer f.Close()
+	tr, err := emu.NewTraceReader(f)
+	if err != nil {
+		return fail(fmt.Errorf("%s: %v", files[0], err))
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	for {
+		r, err := tr.Next()
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			return fail(fmt.Errorf("%s: %v", files[0], err))
+		}
+		if filter.Match(r) {
+			fmt.Fprintln(out, r.Format(syms))
+		}
+	}
+}
+
+// newTracer starts a trace to w with the run command's filters and size limit
+func newTracer(w io.Writer, syms *emu.Symbols, rom, ram, max string) (*emu.Tracer, error) {
+	filter, err := traceFilter(syms, rom, ram)
+	if err != nil {
+		return nil, err
+	}
+	size, err := parseSize(max)
+	if err != nil {
+		return nil, err
+	}
+	return emu.NewTracer(w, filter, size)
+}
+
+// traceFilter reads ROM and RAM ranges, either of which may be empty
+func traceFilter(syms *emu.Symbols, rom, ram string) (emu.TraceFilter, error) {
+	var filter emu.TraceFilter
+	var err error
+	if rom != "" {
+		if filter.ROM, err = syms.ParseROMSpans(rom); err != nil {
+			return filter, fmt.Errorf("ROM ranges %q: %v", rom, err)
+		}
+	}
+	if ram != "" {
+		if filter.RAM, err = syms.ParseRAMSpans(ram); err != nil {
+			return filter, fmt.Errorf("RAM ranges %q: %v", ram, err)
+		}
+	}
+	return filter, nil
+}
+
+// parseSize reads a number of bytes with an optional K, M or G suffix
+func parseSize(s string) (int64, error) {
+	scale := int64(1)
+	for i, suffix := range []string{"K", "M", "G"} {
+		if strings.HasSuffix(s, suffix) {
+			s, scale = strings.TrimSuffix(s, suffix), int64(1)<<(10*(i+1))
+		}
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("bad size %q: want bytes, with an optional K, M or G", s)
+	}
+	return n * scale, nil
+}
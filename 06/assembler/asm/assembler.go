@@ -0,0 +1,150 @@
+package asm
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"diagnostic"
+)
+
+// RomSize is the number of instructions that fit in the Hack ROM
+const RomSize = 32768
+
+// Assembler holds the settings used to translate programs
+type Assembler struct {
+	ISA     *ISA    // instruction set and predefined symbols
+	Defines Defines // names for .if, .ifdef and @NAME, as given with -D
+}
+
+// NewAssembler creates an Assembler for the standard Hack profile
+func NewAssembler() *Assembler {
+	a := &Assembler{
+		ISA: standard,
+	}
+	return a
+}
+
+// Program is the result of assembling a source file
+type Program struct {
+	ISA      *ISA                    // profile the program was assembled with
+	Commands []Command               // every parsed command, including labels
+	Symbols  *SymbolTable            // predefined symbols, labels and variables
+	Code     []uint16                // binary code of each instruction, indexed by ROM address
+	Diags    []diagnostic.Diagnostic // problems found in either pass
+	ignores  map[int][]string        // lint codes suppressed by comments, by line
+}
+
+// Assemble translates the assembly read from r with the standard profile
+func Assemble(r io.Reader) *Program {
+	return NewAssembler().Assemble(r)
+}
+
+// Assemble translates the assembly read from r in two passes
+func (a *Assembler) Assemble(r io.Reader) *Program {
+	p := &Program{
+		ISA:     a.ISA,
+		Symbols: a.ISA.NewSymbolTable(),
+	}
+
+	// First Pass
+	// Scan for labels (add to Symbol Table with the address of the next instruction)
+	numLines := 0
+	parser := NewParser(r)
+	parser.defines = a.Defines
+	p.Commands = make([]Command, 0, sizeHint(r)/bytesPerCommand)
+	for parser.Advance() {
+		c := parser.Command()
+		c.Addr = numLines
+		if c.Type == LCommand {
+			if sym, ok := p.Symbols.Lookup(c.Symbol); ok {
+				d := diagnostic.Errorf(c.Line, c.SymCol, "duplicate-label", "%s %s is already defined", sym.Kind, c.Symbol)
+				if sym.Kind == Label {
+					d = d.WithFix("rename this label or the one on line %d", sym.Line)
+				} else {
+					d = d.WithFix("rename the label, %s is a predefined symbol", c.Symbol)
+				}
+				p.Diags = append(p.Diags, d)
+			} else {
+				p.Symbols.AddEntry(&Symbol{Name: c.Symbol, Address: numLines, Kind: Label, Line: c.Line, Col: c.SymCol})
+			}
+		} else {
+			numLines++
+		}
+		p.Commands = append(p.Commands, c)
+	}
+	p.Diags = append(parser.Diagnostics(), p.Diags...)
+	p.ignores = parser.ignores
+
+	// .string blocks come first in RAM, before the variables
+	for _, b := range parser.blocks {
+		if sym, ok := p.Symbols.Lookup(b.name); ok {
+			d := diagnostic.Errorf(b.line, b.col, "duplicate-symbol", "%s %s is already defined", sym.Kind, b.name)
+			p.Diags = append(p.Diags, d.WithFix("give the string a name of its own"))
+			continue
+		}
+		p.Symbols.AddBlock(b.name, b.size, b.line, b.col)
+	}
+	if numLines > RomSize {
+		p.Diags = append(p.Diags, diagnostic.Errorf(parser.line, 0, "program-too-large", "program has %d instructions, ROM holds %d", numLines, RomSize))
+	}
+
+	p.Code = make([]uint16, 0, numLines)
+
+	// Second Pass
+	// Translate each instruction
+	// Replace variables with Symbol Table value (or add to Symbol Table if first instance)
+	for i := range p.Commands {
+		c := &p.Commands[i]
+		switch c.Type {
+		case ACommand:
+			v := c.Value
+			if charClass[c.Symbol[0]] != classDigit {
+				sym, ok := p.Symbols.Lookup(c.Symbol)
+				if !ok {
+					sym = p.Symbols.AddVariable(c.Symbol, c.Line, c.SymCol)
+				}
+				v = sym.Address + c.Offset
+			}
+			p.Code = append(p.Code, uint16(v))
+		case CCommand:
+			instr, err := a.ISA.encodeC(c)
+			if err != nil {
+				p.Diags = append(p.Diags, *err)
+			}
+			p.Code = append(p.Code, instr)
+		}
+	}
+	diagnostic.Sort(p.Diags)
+	return p
+}
+
+// bytesPerCommand is a low estimate of the source bytes per command, used to size the
+// Commands slice up front instead of growing it on very large inputs
+const bytesPerCommand = 12
+
+// sizeHint returns the number of bytes left in r, if r can tell
+func sizeHint(r io.Reader) int {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return r.Len()
+	case *os.File:
+		if fi, err := r.Stat(); err == nil && fi.Mode().IsRegular() {
+			return int(fi.Size())
+		}
+	}
+	return 0
+}
+
+// Write writes the binary code to w, one instruction per line
+func (p *Program) Write(w io.Writer) error {
+	bw := bufio.NewWriterSize(w, 64*1024)
+	line := [17]byte{16: '\n'}
+	for _, word := range p.Code {
+		putWord(line[:], word)
+		if _, err := bw.Write(line[:]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,565 @@
+package asm
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"diagnostic"
+)
+
+// TestGolden assembles the course's test programs and compares with their expected .hack files.
+// Big stands in for the course's Pong at about the same size: it is the 08 translator's output
+// for 08/testdata/Big, with thousands of labels and calls.
+func TestGolden(t *testing.T) {
+	for _, name := range []string{"Add", "Max", "Rect", "Big"} {
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join("testdata", name+".asm"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", name+".hack"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			prog := Assemble(bytes.NewReader(src))
+			if len(prog.Diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", prog.Diags)
+			}
+			var got bytes.Buffer
+			if err := prog.Write(&got); err != nil {
+				t.Fatal(err)
+			}
+			gotLines := strings.Split(strings.TrimSpace(got.String()), "\n")
+			wantLines := strings.Split(strings.TrimSpace(string(want)), "\n")
+			if len(gotLines) != len(wantLines) {
+				t.Fatalf("got %d instructions, want %d", len(gotLines), len(wantLines))
+			}
+			for i := range wantLines {
+				if _, err := ParseWord(strings.TrimSpace(wantLines[i])); err != nil {
+					t.Fatalf("%s.hack line %d: %v", name, i+1, err)
+				}
+				if gotLines[i] != strings.TrimSpace(wantLines[i]) {
+					t.Errorf("ROM[%d] = %s, want %s", i, gotLines[i], wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNoPanic(t *testing.T) {
+	inputs := []string{
+		" ",
+		"\t\n",
+		"/",
+		"(",
+		")",
+		"()",
+		"@",
+		"@ ",
+		"=",
+		";",
+		"=;",
+		"D=",
+		";JMP",
+		"@99999999999999999999",
+		"@-1",
+		"(1LOOP)",
+		"(LOOP)\n(LOOP)",
+		"(R0)",
+		"AMD=M+1;JMP;JMP",
+		"\x00\xff",
+	}
+	for _, src := range inputs {
+		Assemble(strings.NewReader(src))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		code string
+		line int
+	}{
+		{"@R0\nD=Q", "unknown-comp", 2},
+		{"M+D;JMP", "unknown-comp", 1},
+		{"DM=1", "unknown-dest", 1},
+		{"0;JMPP", "unknown-jump", 1},
+		{"@32768", "constant-too-large", 1},
+		{"@a-b", "invalid-symbol", 1},
+		{"\n\n(END", "unclosed-label", 3},
+		{"(END)\n(END)", "duplicate-label", 2},
+		{"(SCREEN)", "duplicate-label", 1},
+		{".if X\n.endif", "undefined-name", 1},
+		{".ifdef X\n@1", "unclosed-if", 1},
+		{"@1\n.else", "unmatched-else", 2},
+		{".rept 2\n@1", "unclosed-rept", 1},
+		{".rept 2\n.if 1\n.endr", "unbalanced-if", 1},
+		{".rept 40000\n.endr", "invalid-count", 1},
+		{".endr", "unmatched-endr", 1},
+		{".macro", "unknown-directive", 1},
+		{"@'AB'", "invalid-char", 1},
+		{"@'é'", "invalid-char", 1},
+		{`.string S "a"b"`, "invalid-char", 1},
+		{".string S abc", "wrong-arguments", 1},
+		{"(S)\n.string S \"\"", "duplicate-symbol", 2},
+	}
+	for _, tt := range tests {
+		prog := Assemble(strings.NewReader(tt.src))
+		if len(prog.Diags) != 1 {
+			t.Errorf("%q: got %v, want one %s", tt.src, prog.Diags, tt.code)
+			continue
+		}
+		if d := prog.Diags[0]; d.Code != tt.code || d.Line != tt.line || d.Severity != diagnostic.Error {
+			t.Errorf("%q: got %v, want %s on line %d", tt.src, d, tt.code, tt.line)
+		}
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		word uint16
+		want string
+	}{
+		{0b0000000000000111, "@7"},
+		{0b0111111111111111, "@32767"},
+		{0b1110101010000111, "0;JMP"},
+		{0b1111110010011000, "MD=M-1"},
+		{0b1110001100000001, "D;JGT"},
+		{0b1110000010111111, "AMD=D+A;JMP"},
+	}
+	for _, tt := range tests {
+		got, err := Disassemble(tt.word)
+		if err != nil || got != tt.want {
+			t.Errorf("Disassemble(%016b) = %q, %v, want %q", tt.word, got, err, tt.want)
+		}
+	}
+	for _, word := range []uint16{0b1010101010101010, 0b1111111111111111} {
+		if _, err := Disassemble(word); err == nil {
+			t.Errorf("Disassemble(%016b) succeeded, want an error", word)
+		}
+	}
+}
+
+func TestParseWord(t *testing.T) {
+	for _, word := range []uint16{0, 1, 0x7fff, 0xec10, 0xffff} {
+		got, err := ParseWord(FormatWord(word))
+		if err != nil || got != word {
+			t.Errorf("ParseWord(FormatWord(%d)) = %d, %v", word, got, err)
+		}
+	}
+	for _, text := range []string{"", "0101", "000000000000000x", "00000000000000000"} {
+		if _, err := ParseWord(text); err == nil {
+			t.Errorf("ParseWord(%q) succeeded, want an error", text)
+		}
+	}
+}
+
+// TestRoundTripRandom checks that disassembling and reassembling random valid programs gives the same code
+func TestRoundTripRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		data := make([]byte, r.Intn(300))
+		r.Read(data)
+		roundTrip(t, genProgram(data))
+	}
+}
+
+// roundTrip assembles src, disassembles the code and assembles that again, requiring identical bits
+func roundTrip(t *testing.T, src string) {
+	t.Helper()
+	prog := Assemble(strings.NewReader(src))
+	if diagnostic.HasErrors(prog.Diags) {
+		t.Fatalf("valid program failed to assemble: %v\n%s", prog.Diags, src)
+	}
+
+	var dis strings.Builder
+	for addr, word := range prog.Code {
+		instr, err := Disassemble(word)
+		if err != nil {
+			t.Fatalf("ROM[%d]: %v\n%s", addr, err, src)
+		}
+		dis.WriteString(instr + "\n")
+	}
+
+	again := Assemble(strings.NewReader(dis.String()))
+	if len(again.Diags) > 0 {
+		t.Fatalf("disassembly failed to assemble: %v\n%s", again.Diags, dis.String())
+	}
+	if len(again.Code) != len(prog.Code) {
+		t.Fatalf("reassembled %d instructions, want %d", len(again.Code), len(prog.Code))
+	}
+	for addr := range prog.Code {
+		if again.Code[addr] != prog.Code[addr] {
+			t.Fatalf("ROM[%d] reassembled as %016b, want %016b\n%s", addr, again.Code[addr], prog.Code[addr], src)
+		}
+	}
+}
+
+// genProgram builds a valid program from data, three bytes per command,
+// so that the fuzzer explores programs rather than arbitrary text
+func genProgram(data []byte) string {
+	comps := CompMnemonics()
+	dests := DestMnemonics()
+	jumps := JumpMnemonics()
+
+	var b strings.Builder
+	labels := 0
+	for i := 0; i+2 < len(data); i += 3 {
+		op, x, y := data[i], int(data[i+1]), int(data[i+2])
+		switch op % 5 {
+		case 0:
+			b.WriteString("@" + strconv.Itoa((x<<8|y)%(MaxConstant+1)) + "\n")
+		case 1:
+			b.WriteString("@var" + strconv.Itoa(x%8) + "\n")
+		case 2:
+			b.WriteString("(L" + strconv.Itoa(labels) + ")\n")
+			labels++
+		case 3:
+			// may refer to a label that is declared later, or never (making it a variable)
+			b.WriteString("@L" + strconv.Itoa(x%(labels+2)) + "\n")
+		case 4:
+			instr := comps[x%len(comps)].Name
+			if d := y % (len(dests) + 1); d < len(dests) {
+				instr = dests[d].Name + "=" + instr
+			}
+			if j := int(op/5) % (len(jumps) + 1); j < len(jumps) {
+				instr += ";" + jumps[j].Name
+			}
+			b.WriteString(instr + "\n")
+		}
+	}
+	return b.String()
+}
+
+func TestLoadISA(t *testing.T) {
+	profile := `// extended board
+name test
+comp D<< 1010110000
+comp D+A 0010011 // override
+symbol SCREEN 8192
+symbol MOUSE 24577
+`
+	isa, err := LoadISA(strings.NewReader(profile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAssembler()
+	a.ISA = isa
+	prog := a.Assemble(strings.NewReader("@SCREEN\nD=D<<\n@MOUSE\nD=D+A\n@KBD\n"))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	want := []uint16{8192, 0b1010110000010000, 24577, 0b1110010011010000, 24576}
+	for i, word := range want {
+		if prog.Code[i] != word {
+			t.Errorf("ROM[%d] = %016b, want %016b", i, prog.Code[i], word)
+		}
+	}
+	if got, err := isa.Disassemble(0b1010110000010000); err != nil || got != "D=D<<" {
+		t.Errorf("Disassemble(D=D<<) = %q, %v", got, err)
+	}
+
+	// the standard profile is unchanged
+	if prog := Assemble(strings.NewReader("D=D<<")); len(prog.Diags) != 1 {
+		t.Errorf("standard profile accepted D<<")
+	}
+
+	for _, bad := range []string{"comp X 0110000000", "comp X 12", "dest M 1", "symbol 1X 3", "symbol X 40000", "bogus X 1", "comp A=B 0000000"} {
+		if _, err := LoadISA(strings.NewReader(bad)); err == nil {
+			t.Errorf("LoadISA(%q) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestSymbolFile(t *testing.T) {
+	prog := Assemble(strings.NewReader("@i\nM=0\n(LOOP)\n@LOOP\n0;JMP\n(END)\n@sum\n"))
+	var buf bytes.Buffer
+	if err := prog.WriteSymbols(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "label LOOP 2\nlabel END 4\nvariable i 16\nvariable sum 17\n"
+	if buf.String() != want {
+		t.Errorf("WriteSymbols wrote\n%s\nwant\n%s", buf.String(), want)
+	}
+	syms, err := ReadSymbols(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(syms) != 4 || syms[1].Name != "END" || syms[1].Kind != Label || syms[3].Address != 17 {
+		t.Errorf("ReadSymbols did not round-trip: %v", syms)
+	}
+	if _, err := ReadSymbols(strings.NewReader("constant X 3")); err == nil {
+		t.Error("ReadSymbols accepted an unknown kind")
+	}
+}
+
+func TestDirectives(t *testing.T) {
+	src := `.ifdef DEBUG
+@DEBUG
+.else
+@1
+.endif
+.if LEVEL >= 2
+.rept 2 i
+(SKIP)
+.endr
+.else
+.rept ROWS row
+.rept 2 col
+.if col == 0
+@row
+.else
+@col
+.endif
+.endr
+.endr
+.endif
+(END)
+@END
+.ifndef DEBUG
+@99
+.endif
+`
+	a := NewAssembler()
+	a.Defines = Defines{"DEBUG": 7, "LEVEL": 1, "ROWS": 3}
+	prog := a.Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	// row 0: 0 1, row 1: 1 1, row 2: 2 1; END follows them at address 7
+	want := []uint16{7, 0, 1, 1, 1, 2, 1, 7}
+	if len(prog.Code) != len(want) {
+		t.Fatalf("got %v, want %v", prog.Code, want)
+	}
+	for i, word := range want {
+		if prog.Code[i] != word {
+			t.Errorf("ROM[%d] = %d, want %d", i, prog.Code[i], word)
+		}
+	}
+	// repeated commands keep their source line
+	if prog.Commands[1].Line != 14 || prog.Commands[2].Line != 16 {
+		t.Errorf("repeated commands on lines %d and %d, want 14 and 16", prog.Commands[1].Line, prog.Commands[2].Line)
+	}
+
+	// the .rept is skipped, so SKIP is never defined
+	a.Defines["LEVEL"] = 2
+	prog = a.Assemble(strings.NewReader(src))
+	if len(prog.Diags) != 1 || prog.Diags[0].Code != "duplicate-label" {
+		t.Errorf("got %v, want a duplicate SKIP label", prog.Diags)
+	}
+
+	// nested blocks are limited as a whole, and reported once
+	prog = Assemble(strings.NewReader(".rept 1024\n.rept 1024\n@1\n@2\n.endr\n.endr"))
+	if len(prog.Diags) == 0 || prog.Diags[0].Code != "rept-too-large" || prog.Diags[0].Line != 2 {
+		t.Errorf("got %v, want rept-too-large on line 2", prog.Diags)
+	}
+}
+
+func TestDefinesFlag(t *testing.T) {
+	d := Defines{}
+	for _, s := range []string{"DEBUG", "ROWS=16", "LOW=-1"} {
+		if err := d.Set(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := d.String(); got != "DEBUG=1,LOW=-1,ROWS=16" {
+		t.Errorf("String() = %q", got)
+	}
+	for _, bad := range []string{"=1", "1X=2", "X=y"} {
+		if err := d.Set(bad); err == nil {
+			t.Errorf("Set(%q) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	src := `@'A'
+@'\''
+@' ' // space
+@KEY_LEFT
+@x
+.string S "a\n\"//"
+@S
+`
+	prog := Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	want := []uint16{65, 39, 32, 130, 22}
+	// S is 6 words at 16, before x; its text is stored by 4 instructions per word
+	for i, word := range []int{5, 'a', 128, '"', '/', '/'} {
+		want = append(want, uint16(word), 0b1110110000010000, uint16(16+i), 0b1110001100001000)
+	}
+	want = append(want, 16)
+	if len(prog.Code) != len(want) {
+		t.Fatalf("got %d words, want %d", len(prog.Code), len(want))
+	}
+	for i, word := range want {
+		if prog.Code[i] != word {
+			t.Errorf("ROM[%d] = %016b, want %016b", i, prog.Code[i], word)
+		}
+	}
+	// the commands of the .string point at its name and its text
+	for _, c := range prog.Commands {
+		if c.Line != 6 || c.Type != ACommand {
+			continue
+		}
+		want := 11
+		if c.Symbol == "S" {
+			want = 9
+		}
+		if c.SymCol != want {
+			t.Errorf("%s on line 6 has SymCol %d, want %d", c.Text, c.SymCol, want)
+		}
+	}
+}
+
+func TestCrossReference(t *testing.T) {
+	prog := Assemble(strings.NewReader("@n\nD=M\n@LOOP\n(LOOP)\nD;JGT\n@n\nAM=M-1\n@n\n@R0\nM=D\n"))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	refs := prog.CrossReference()
+	got := map[string][]string{}
+	for _, ref := range refs {
+		for _, u := range ref.Uses {
+			got[ref.Symbol.Name] = append(got[ref.Symbol.Name], strconv.Itoa(u.Addr)+" "+u.Access.String())
+		}
+	}
+	want := map[string][]string{
+		"LOOP": {"2 jump"},
+		"n":    {"0 read", "4 read+write", "6 value"},
+		"R0":   {"7 write"},
+	}
+	for name, uses := range want {
+		if strings.Join(got[name], ", ") != strings.Join(uses, ", ") {
+			t.Errorf("%s: got %v, want %v", name, got[name], uses)
+		}
+	}
+	if len(refs) != 3 {
+		t.Errorf("got %d symbols, want the 3 that are defined or used", len(refs))
+	}
+}
+
+func TestAllErrors(t *testing.T) {
+	// errors from both passes are collected and sorted, and good lines still assemble
+	prog := Assemble(strings.NewReader("@1\nD=Q\n(X\n@99999\n(L)\nM=D;JMPP\n(L)\n@L\n"))
+	want := []struct {
+		code string
+		line int
+	}{{"unknown-comp", 2}, {"unclosed-label", 3}, {"constant-too-large", 4}, {"unknown-jump", 6}, {"duplicate-label", 7}}
+	if len(prog.Diags) != len(want) {
+		t.Fatalf("got %v", prog.Diags)
+	}
+	for i, w := range want {
+		if d := prog.Diags[i]; d.Code != w.code || d.Line != w.line {
+			t.Errorf("diagnostic %d: got %v, want %s on line %d", i, d, w.code, w.line)
+		}
+	}
+	if len(prog.Code) != 4 || prog.Code[0] != 1 || prog.Code[3] != 2 {
+		t.Errorf("got code %v", prog.Code)
+	}
+}
+
+func TestLint(t *testing.T) {
+	src := `D=M
+@x
+M=D;JGT
+(LOOP)
+D=M
+@SP
+AM=M-1
+AM=M-1 // lint:ignore am-write
+@LOOP
+D;JEQ
+D=M
+(QUIET)
+D=M // lint:ignore
+@LOOP
+0;JMP
+`
+	prog := Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	want := []struct {
+		code string
+		line int
+	}{{"stale-a", 1}, {"m-and-jump", 3}, {"stale-a", 5}, {"am-write", 7}, {"stale-a", 11}}
+	got := prog.Lint()
+	if len(got) != len(want) {
+		t.Fatalf("got %v", got)
+	}
+	for i, w := range want {
+		if d := got[i]; d.Code != w.code || d.Line != w.line || d.Severity != diagnostic.Warning {
+			t.Errorf("warning %d: got %v, want %s on line %d", i, d, w.code, w.line)
+		}
+	}
+}
+
+func TestCFG(t *testing.T) {
+	src := `(TOP)
+@KBD
+D=M
+@TOP
+D;JEQ
+@ret
+D=A
+@R13
+M=D
+@SUB
+0;JMP
+(ret)
+@TOP
+0;JMP
+(DEAD)
+D=0
+(SUB)
+@R13
+A=M
+0;JMP
+`
+	prog := Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatal(prog.Diags)
+	}
+	g := prog.CFG()
+	// TOP, the call, ret, DEAD, SUB
+	starts := []int{}
+	for _, b := range g.Blocks {
+		starts = append(starts, b.Start)
+	}
+	if got := fmt.Sprint(starts); got != "[0 4 10 12 13]" {
+		t.Fatalf("blocks start at %s", got)
+	}
+	edges := []string{}
+	for _, e := range g.Edges {
+		edges = append(edges, fmt.Sprintf("%d->%d %s", e.From, e.To, e.Kind))
+	}
+	want := "0->0 conditional, 0->1 fallthrough, 1->4 unconditional, 2->0 unconditional, 3->4 fallthrough"
+	if got := strings.Join(edges, ", "); got != want {
+		t.Errorf("edges: got %s, want %s", got, want)
+	}
+	if !g.Blocks[4].Indirect {
+		t.Error("SUB's return jump is not marked indirect")
+	}
+	// ret is reached through the computed return; DEAD is never reached
+	if un := g.Unreachable(); len(un) != 1 || un[0].Labels[0] != "DEAD" {
+		t.Errorf("unreachable blocks: %v", un)
+	}
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(dot.String(), `b3 [label="(DEAD)\lROM[12..12]\lD=0\l", style=filled`) {
+		t.Errorf("DOT does not highlight DEAD:\n%s", dot.String())
+	}
+}
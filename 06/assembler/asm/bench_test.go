@@ -0,0 +1,81 @@
+package asm
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// benchLines is the size of the generated benchmark input, about what the VM translator
+// produces for a full Jack program
+const benchLines = 500000
+
+var benchSource []byte
+
+// vmStyleSource generates at least n lines of assembly shaped like the 08 CodeWriter output:
+// comments, tab-indented instructions, statics, return labels and comparison labels
+func vmStyleSource(n int) []byte {
+	if benchSource != nil {
+		return benchSource
+	}
+	var b strings.Builder
+	lines := 0
+	for i := 0; lines < n; i++ {
+		fn := "Main.f" + strconv.Itoa(i)
+		callee := "Main.f" + strconv.Itoa(i/2)
+		ret := strconv.Itoa(i)
+		b.WriteString("// function " + fn + " 0\n(" + fn + ")\n")
+		b.WriteString("// push constant 7\n\t@7\n\tD=A\n\t@SP\n\tA=M\n\tM=D\n\t@SP\n\tM=M+1\n")
+		b.WriteString("// push static 3\n\t@Main." + strconv.Itoa(i%200) + "\n\tD=M\n\t@SP\n\tA=M\n\tM=D\n\t@SP\n\tM=M+1\n")
+		b.WriteString("// eq\n\t@SP\n\tM=M-1\n\tA=M\n\tD=M\n\t@SP\n\tM=M-1\n\tA=M\n\tD=M-D\n")
+		b.WriteString("\t@R13\n\tM=-1\n\t@EVAL_" + ret + "\n\tD;JEQ\n\t@R13\n\tM=0\n(EVAL_" + ret + ")\n\t@R13\n\tD=M\n")
+		b.WriteString("// call " + callee + " 0\n\t@" + callee + "$ret" + ret + "\n\tD=A\n\t@SP\n\tA=M\n\tM=D\n\t@SP\n\tM=M+1\n")
+		b.WriteString("\t@" + callee + "\n\t0;JMP\n(" + callee + "$ret" + ret + ")\n")
+		lines += 52
+	}
+	benchSource = []byte(b.String())
+	return benchSource
+}
+
+func BenchmarkAssemble(b *testing.B) {
+	src := vmStyleSource(benchLines)
+	b.SetBytes(int64(len(src)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prog := Assemble(bytes.NewReader(src))
+		for _, d := range prog.Diags {
+			// the input is larger than the Hack ROM, which is reported but does not stop translation
+			if d.Code != "program-too-large" {
+				b.Fatal(d)
+			}
+		}
+	}
+	b.ReportMetric(float64(benchLines)*float64(b.N)/b.Elapsed().Seconds(), "lines/s")
+}
+
+func BenchmarkWrite(b *testing.B) {
+	prog := Assemble(bytes.NewReader(vmStyleSource(benchLines)))
+	b.SetBytes(int64(len(prog.Code) * 17))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := prog.Write(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkAssembleAndWrite measures the whole translation, as the command line tool runs it
+func BenchmarkAssembleAndWrite(b *testing.B) {
+	src := vmStyleSource(benchLines)
+	b.SetBytes(int64(len(src)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prog := Assemble(bytes.NewReader(src))
+		if err := prog.Write(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(benchLines)*float64(b.N)/b.Elapsed().Seconds(), "lines/s")
+}
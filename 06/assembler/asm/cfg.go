@@ -0,0 +1,333 @@
+package asm
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// EdgeKind tells how control passes from one basic block to another
+type EdgeKind int
+
+const (
+	// FallThrough is reaching the next block without jumping
+	FallThrough EdgeKind = iota
+	// Conditional is a jump that is taken depending on the computed value
+	Conditional
+	// Unconditional is a jump that is always taken (;JMP, or a condition on a constant)
+	Unconditional
+)
+
+var edgeKindNames = []string{"fallthrough", "conditional", "unconditional"}
+
+func (k EdgeKind) String() string {
+	return edgeKindNames[k]
+}
+
+// MarshalText writes the kind by name in JSON
+func (k EdgeKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Block is a basic block: a run of instructions entered only at the top and left only at the bottom
+type Block struct {
+	ID        int      `json:"id"`
+	Start     int      `json:"start"` // ROM address of the first instruction
+	End       int      `json:"end"`   // ROM address after the last instruction
+	Labels    []string `json:"labels"`
+	Instrs    []string `json:"instructions"`
+	Indirect  bool     `json:"indirect"` // ends in a jump to a computed address
+	Reachable bool     `json:"reachable"`
+}
+
+// Edge joins two blocks
+type Edge struct {
+	From int      `json:"from"`
+	To   int      `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	Jump string   `json:"jump,omitempty"` // jump mnemonic, for jump edges
+}
+
+// CFG is the control-flow graph of a program
+type CFG struct {
+	Blocks []*Block `json:"blocks"`
+	Edges  []Edge   `json:"edges"`
+}
+
+// CFG builds the control-flow graph of the program. Jump targets come from the A-instruction
+// before the jump in the same block. Jumps to computed addresses (A=M;JMP, as in the VM
+// translator's return) cannot be resolved, so once one is reachable, every label that is
+// loaded as data (such as a return address) is counted as reachable too.
+func (p *Program) CFG() *CFG {
+	n := len(p.Code)
+	instrs := make([]*Command, n)
+	labels := map[int][]string{}
+	for i := range p.Commands {
+		c := &p.Commands[i]
+		switch {
+		case c.Type == LCommand:
+			labels[c.Addr] = append(labels[c.Addr], c.Symbol)
+		case c.Addr < n:
+			instrs[c.Addr] = c
+		}
+	}
+
+	// find jump targets and the labels used as data
+	targets := map[int]int{} // jump address -> target, or -1 if computed
+	addrTaken := map[int]bool{}
+	leaders := map[int]bool{0: true}
+	known, value := false, 0
+	for addr, c := range instrs {
+		if len(labels[addr]) > 0 {
+			leaders[addr] = true
+			known = false
+		}
+		if c == nil {
+			continue
+		}
+		switch c.Type {
+		case ACommand:
+			known, value = true, p.addressOf(c)
+			next := addr + 1
+			if sym, ok := p.Symbols.Lookup(c.Symbol); ok && sym.Kind == Label && (next >= n || instrs[next] == nil || instrs[next].Jump == "") {
+				addrTaken[value] = true
+			}
+		case CCommand:
+			if c.Jump != "" {
+				targets[addr] = -1
+				if known {
+					targets[addr] = value
+					if value < n {
+						leaders[value] = true
+					}
+				}
+				if addr+1 < n {
+					leaders[addr+1] = true
+				}
+			}
+			if strings.IndexByte(c.Dest, 'A') >= 0 {
+				known = false
+			}
+		}
+	}
+
+	g := &CFG{Blocks: []*Block{}, Edges: []Edge{}}
+	if n == 0 {
+		return g
+	}
+	starts := make([]int, 0, len(leaders))
+	for addr := range leaders {
+		starts = append(starts, addr)
+	}
+	sort.Ints(starts)
+	blockOf := make([]int, n)
+	for i, start := range starts {
+		end := n
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		b := &Block{ID: i, Start: start, End: end, Labels: labels[start], Instrs: []string{}}
+		if b.Labels == nil {
+			b.Labels = []string{}
+		}
+		for addr := start; addr < end; addr++ {
+			blockOf[addr] = i
+			if instrs[addr] != nil {
+				b.Instrs = append(b.Instrs, instrs[addr].Text)
+			}
+		}
+		g.Blocks = append(g.Blocks, b)
+	}
+
+	for _, b := range g.Blocks {
+		last := instrs[b.End-1]
+		taken, fallThrough := false, true
+		if target, ok := targets[b.End-1]; ok && last != nil {
+			switch jumpTaken(last.Comp, last.Jump) {
+			case always:
+				taken, fallThrough = true, false
+			case maybe:
+				taken = true
+			}
+			kind := Conditional
+			if !fallThrough {
+				kind = Unconditional
+			}
+			switch {
+			case !taken:
+			case target < 0:
+				b.Indirect = true
+			case target < n:
+				g.Edges = append(g.Edges, Edge{From: b.ID, To: blockOf[target], Kind: kind, Jump: last.Jump})
+			}
+		}
+		if fallThrough && b.End < n {
+			g.Edges = append(g.Edges, Edge{From: b.ID, To: b.ID + 1, Kind: FallThrough})
+		}
+	}
+
+	g.markReachable(blockOf, addrTaken)
+	return g
+}
+
+// addressOf returns the value an A-command loads
+func (p *Program) addressOf(c *Command) int {
+	if charClass[c.Symbol[0]] == classDigit {
+		return c.Value
+	}
+	if sym, ok := p.Symbols.Lookup(c.Symbol); ok {
+		return sym.Address + c.Offset
+	}
+	return -1
+}
+
+// outcomes of a jump
+const (
+	never = iota
+	maybe
+	always
+)
+
+// jumpTaken works out whether a jump is taken when comp is a constant
+func jumpTaken(comp, jump string) int {
+	if jump == "JMP" {
+		return always
+	}
+	v := 0
+	switch comp {
+	case "0":
+	case "1":
+		v = 1
+	case "-1":
+		v = -1
+	default:
+		return maybe
+	}
+	var taken bool
+	switch jump {
+	case "JGT":
+		taken = v > 0
+	case "JEQ":
+		taken = v == 0
+	case "JGE":
+		taken = v >= 0
+	case "JLT":
+		taken = v < 0
+	case "JNE":
+		taken = v != 0
+	case "JLE":
+		taken = v <= 0
+	default:
+		return maybe
+	}
+	if taken {
+		return always
+	}
+	return never
+}
+
+// markReachable walks the graph from address 0
+func (g *CFG) markReachable(blockOf []int, addrTaken map[int]bool) {
+	succs := make([][]int, len(g.Blocks))
+	for _, e := range g.Edges {
+		succs[e.From] = append(succs[e.From], e.To)
+	}
+	queue := []int{0}
+	g.Blocks[0].Reachable = true
+	indirect := false
+	for len(queue) > 0 {
+		b := g.Blocks[queue[0]]
+		queue = queue[1:]
+		next := succs[b.ID]
+		if b.Indirect && !indirect {
+			indirect = true
+			for addr := range addrTaken {
+				if addr >= 0 && addr < len(blockOf) {
+					next = append(next, blockOf[addr])
+				}
+			}
+		}
+		for _, id := range next {
+			if !g.Blocks[id].Reachable {
+				g.Blocks[id].Reachable = true
+				queue = append(queue, id)
+			}
+		}
+	}
+}
+
+// Unreachable returns the blocks that cannot be reached from address 0
+func (g *CFG) Unreachable() []*Block {
+	blocks := []*Block{}
+	for _, b := range g.Blocks {
+		if !b.Reachable {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// WriteJSON writes the graph as JSON
+func (g *CFG) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// WriteDOT writes the graph in Graphviz DOT format, with unreachable blocks filled in red.
+// Fall-through edges are dashed, conditional jumps are labelled with their condition and
+// jumps to computed addresses point to a single "indirect" node.
+func (g *CFG) WriteDOT(w io.Writer, name string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %s {\n", dotQuote(name))
+	fmt.Fprintln(bw, "\tnode [shape=box, fontname=\"monospace\"];")
+	indirect := false
+	for _, b := range g.Blocks {
+		var label strings.Builder
+		for _, l := range b.Labels {
+			fmt.Fprintf(&label, "(%s)\n", l)
+		}
+		fmt.Fprintf(&label, "ROM[%d..%d]\n", b.Start, b.End-1)
+		for _, instr := range b.Instrs {
+			label.WriteString(instr + "\n")
+		}
+		attrs := ""
+		if !b.Reachable {
+			attrs = `, style=filled, fillcolor="#f4cccc", color=red`
+		}
+		fmt.Fprintf(bw, "\tb%d [label=%s%s];\n", b.ID, dotLabel(label.String()), attrs)
+		if b.Indirect {
+			fmt.Fprintf(bw, "\tb%d -> indirect [style=dotted];\n", b.ID)
+			indirect = true
+		}
+	}
+	if indirect {
+		fmt.Fprintln(bw, "\tindirect [shape=ellipse, label=\"computed address\"];")
+	}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case FallThrough:
+			fmt.Fprintf(bw, "\tb%d -> b%d [style=dashed];\n", e.From, e.To)
+		case Conditional:
+			fmt.Fprintf(bw, "\tb%d -> b%d [label=%s, color=blue];\n", e.From, e.To, dotQuote(e.Jump))
+		case Unconditional:
+			fmt.Fprintf(bw, "\tb%d -> b%d [style=bold];\n", e.From, e.To)
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotQuote quotes s as a DOT string
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// dotLabel quotes a multi-line label, with each line left-justified
+func dotLabel(s string) string {
+	q := dotQuote(s)
+	return strings.ReplaceAll(q, "\n", `\l`)
+}
@@ -0,0 +1,135 @@
+package asm
+
+import (
+	"strconv"
+	"strings"
+
+	"diagnostic"
+)
+
+// keyCodes are the Hack keyboard codes of the keys that are not printable characters.
+// Printable characters use their ASCII codes, which @'A' gives.
+var keyCodes = map[string]int{
+	"KEY_NEWLINE":   128,
+	"KEY_BACKSPACE": 129,
+	"KEY_LEFT":      130,
+	"KEY_UP":        131,
+	"KEY_RIGHT":     132,
+	"KEY_DOWN":      133,
+	"KEY_HOME":      134,
+	"KEY_END":       135,
+	"KEY_PAGEUP":    136,
+	"KEY_PAGEDOWN":  137,
+	"KEY_INSERT":    138,
+	"KEY_DELETE":    139,
+	"KEY_ESC":       140,
+	"KEY_F1":        141,
+	"KEY_F2":        142,
+	"KEY_F3":        143,
+	"KEY_F4":        144,
+	"KEY_F5":        145,
+	"KEY_F6":        146,
+	"KEY_F7":        147,
+	"KEY_F8":        148,
+	"KEY_F9":        149,
+	"KEY_F10":       150,
+	"KEY_F11":       151,
+	"KEY_F12":       152,
+}
+
+// KeyCode returns the Hack keyboard code of a key name such as KEY_LEFT
+func KeyCode(name string) (int, bool) {
+	code, ok := keyCodes[name]
+	return code, ok
+}
+
+// KeyName returns the KEY_ name of a Hack keyboard code that is not a printable character
+func KeyName(code int) (string, bool) {
+	for name, c := range keyCodes {
+		if c == code {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// charCode reads one character of a literal: a printable ASCII character, or one of the
+// escapes \n (the Hack newline, 128), \\, \' and \". It returns the code and the rest of s.
+func charCode(s string) (int, string, bool) {
+	if s == "" {
+		return 0, s, false
+	}
+	if s[0] != '\\' {
+		return int(s[0]), s[1:], s[0] >= ' ' && s[0] <= '~'
+	}
+	if len(s) < 2 {
+		return 0, s, false
+	}
+	switch s[1] {
+	case 'n':
+		return keyCodes["KEY_NEWLINE"], s[2:], true
+	case '\\', '\'', '"':
+		return int(s[1]), s[2:], true
+	}
+	return 0, s, false
+}
+
+// parseChar reads the character literal of an A-command, such as @'A'
+func (p *Parser) parseChar(c *Command) bool {
+	s := c.Symbol
+	if len(s) >= 3 && s[len(s)-1] == '\'' {
+		if code, rest, ok := charCode(s[1 : len(s)-1]); ok && rest == "" {
+			c.Value = code
+			c.Symbol = strconv.Itoa(code)
+			return true
+		}
+	}
+	p.report(diagnostic.Errorf(c.Line, c.SymCol, "invalid-char", "invalid character literal %s", s).
+		WithFix(`write one printable ASCII character in quotes, or one of '\n' '\\' '\''; use KEY_ names for other keys`))
+	return false
+}
+
+// stringBlock is RAM reserved by a .string directive
+type stringBlock struct {
+	name      string
+	size      int
+	line, col int
+}
+
+// stringData handles .string NAME "text". It reserves RAM for the length and the character
+// codes of text under NAME, and queues the commands that store them there; the commands
+// run where the directive is, and use A and D.
+func (p *Parser) stringData(text string, col int) {
+	name, quoted, _ := strings.Cut(strings.TrimSpace(text), " ")
+	quoted = strings.TrimSpace(quoted)
+	if !validSymbol(name) || len(quoted) < 2 || quoted[0] != '"' || quoted[len(quoted)-1] != '"' {
+		p.report(diagnostic.Errorf(p.line, col, "wrong-arguments", ".string takes a name and a quoted string").
+			WithFix(`write .string NAME "text"`))
+		return
+	}
+	codes := []int{}
+	for s := quoted[1 : len(quoted)-1]; s != ""; {
+		code, rest, ok := charCode(s)
+		if !ok || (code == '"' && s[0] != '\\') {
+			p.report(diagnostic.Errorf(p.line, col, "invalid-char", "invalid character in string at %q", s).
+				WithFix(`use printable ASCII characters, \n, \\ and \"`))
+			return
+		}
+		codes = append(codes, code)
+		s = rest
+	}
+	words := append([]int{len(codes)}, codes...)
+
+	// the commands' symbols are the name and the quoted text, where they are in the directive
+	nameCol := col + len(".string") + strings.Index(text, name)
+	textCol := col + len(".string") + strings.Index(text, quoted)
+	p.blocks = append(p.blocks, stringBlock{name: name, size: len(words), line: p.line, col: col})
+	for i, word := range words {
+		p.pending = append(p.pending,
+			Command{Type: ACommand, Line: p.line, Col: col, SymCol: textCol, Text: "@" + strconv.Itoa(word), Symbol: strconv.Itoa(word), Value: word},
+			Command{Type: CCommand, Line: p.line, Col: col, Text: "D=A", Dest: "D", Comp: "A"},
+			Command{Type: ACommand, Line: p.line, Col: col, SymCol: nameCol, Text: "@" + name, Symbol: name, Offset: i},
+			Command{Type: CCommand, Line: p.line, Col: col, Text: "M=D", Dest: "M", Comp: "D"},
+		)
+	}
+}
@@ -0,0 +1,234 @@
+package asm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"diagnostic"
+)
+
+// MaxConstant is the largest value that fits in an A-instruction
+const MaxConstant = 32767
+
+// the standard Hack tables, from which Standard builds its profile
+
+var acTable = map[string]uint16{
+	"0":   0b0101010,
+	"1":   0b0111111,
+	"-1":  0b0111010,
+	"D":   0b0001100,
+	"A":   0b0110000,
+	"M":   0b1110000,
+	"!D":  0b0001101,
+	"!A":  0b0110001,
+	"!M":  0b1110001,
+	"-D":  0b0001111,
+	"-A":  0b0110011,
+	"-M":  0b1110011,
+	"D+1": 0b0011111,
+	"A+1": 0b0110111,
+	"M+1": 0b1110111,
+	"D-1": 0b0001110,
+	"A-1": 0b0110010,
+	"M-1": 0b1110010,
+	"D+A": 0b0000010,
+	"D+M": 0b1000010,
+	"D-A": 0b0010011,
+	"D-M": 0b1010011,
+	"A-D": 0b0000111,
+	"M-D": 0b1000111,
+	"D&A": 0b0000000,
+	"D&M": 0b1000000,
+	"D|A": 0b0010101,
+	"D|M": 0b1010101,
+}
+
+var dTable = map[string]uint16{
+	"":    0b000,
+	"M":   0b001,
+	"D":   0b010,
+	"MD":  0b011,
+	"A":   0b100,
+	"AM":  0b101,
+	"AD":  0b110,
+	"AMD": 0b111,
+}
+
+var jTable = map[string]uint16{
+	"":    0b000,
+	"JGT": 0b001,
+	"JEQ": 0b010,
+	"JGE": 0b011,
+	"JLT": 0b100,
+	"JNE": 0b101,
+	"JLE": 0b110,
+	"JMP": 0b111,
+}
+
+// Mnemonic is one entry of the comp, dest or jump tables
+type Mnemonic struct {
+	Name string
+	Bits string
+}
+
+// CompMnemonics returns the comp table of the standard profile, sorted by name
+func CompMnemonics() []Mnemonic {
+	return standard.CompMnemonics()
+}
+
+// DestMnemonics returns the dest table of the standard profile, sorted by name
+func DestMnemonics() []Mnemonic {
+	return standard.DestMnemonics()
+}
+
+// JumpMnemonics returns the jump table of the standard profile, sorted by name
+func JumpMnemonics() []Mnemonic {
+	return standard.JumpMnemonics()
+}
+
+func mnemonics(table map[string]uint16, width int) []Mnemonic {
+	m := []Mnemonic{}
+	for name, bits := range table {
+		if name != "" {
+			m = append(m, Mnemonic{Name: name, Bits: fmt.Sprintf("%0*b", width, bits)})
+		}
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i].Name < m[j].Name })
+	return m
+}
+
+// FormatWord returns the 16 character binary text of a word, as written to .hack files
+func FormatWord(word uint16) string {
+	var text [16]byte
+	putWord(text[:], word)
+	return string(text[:])
+}
+
+// putWord writes the binary text of word into the first 16 bytes of dst
+func putWord(dst []byte, word uint16) {
+	copy(dst[0:8], byteBits[word>>8][:])
+	copy(dst[8:16], byteBits[word&0xff][:])
+}
+
+// byteBits holds the binary text of every byte value
+var byteBits [256][8]byte
+
+func init() {
+	for b := range byteBits {
+		for i := 0; i < 8; i++ {
+			byteBits[b][i] = '0' + byte(b>>(7-i)&1)
+		}
+	}
+}
+
+// encodeC returns the binary code of a C-instruction
+// 111 a cccccc ddd jjj
+func (isa *ISA) encodeC(c *Command) (uint16, *diagnostic.Diagnostic) {
+	comp, ok := isa.Comp[c.Comp]
+	if !ok {
+		d := diagnostic.Errorf(c.Line, fieldCol(c, c.Comp), "unknown-comp", "unknown comp %q", c.Comp)
+		if s := suggest(c.Comp, isa.Comp); s != "" {
+			d = d.WithFix("did you mean %s?", s)
+		}
+		return 0, &d
+	}
+	dest, ok := isa.Dest[c.Dest]
+	if !ok {
+		d := diagnostic.Errorf(c.Line, fieldCol(c, c.Dest), "unknown-dest", "unknown dest %q", c.Dest)
+		if s := suggest(c.Dest, isa.Dest); s != "" {
+			d = d.WithFix("did you mean %s?", s)
+		}
+		return 0, &d
+	}
+	jump, ok := isa.Jump[c.Jump]
+	if !ok {
+		d := diagnostic.Errorf(c.Line, fieldCol(c, c.Jump), "unknown-jump", "unknown jump %q", c.Jump)
+		if s := suggest(c.Jump, isa.Jump); s != "" {
+			d = d.WithFix("did you mean %s?", s)
+		}
+		return 0, &d
+	}
+	return comp<<6 | dest<<3 | jump, nil
+}
+
+// fieldCol returns the column of a dest, comp or jump field of a C-command
+func fieldCol(c *Command, field string) int {
+	if i := strings.Index(c.Text, field); i >= 0 && field != "" {
+		return c.Col + i
+	}
+	return c.Col
+}
+
+// suggest finds the table entry that the unknown mnemonic was probably meant to be:
+// the same letters in a different case or order, or one character away
+func suggest(name string, table map[string]uint16) string {
+	if _, ok := table[strings.ToUpper(name)]; ok {
+		return strings.ToUpper(name)
+	}
+	// prefer a reordering over a one-character typo
+	for _, match := range []func(a, b string) bool{sameLetters, oneEdit} {
+		best := ""
+		for m := range table {
+			if m != "" && match(name, m) && (best == "" || m < best) {
+				best = m
+			}
+		}
+		if best != "" {
+			return best
+		}
+	}
+	return ""
+}
+
+// sameLetters reports whether a and b hold the same characters in any order (D+M and M+D)
+func sameLetters(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[rune]int{}
+	for _, r := range strings.ToUpper(a) {
+		counts[r]++
+	}
+	for _, r := range b {
+		counts[r]--
+	}
+	for _, n := range counts {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// oneEdit reports whether a and b are one insertion, deletion or substitution apart
+func oneEdit(a, b string) bool {
+	return editDistance(a, b) == 1
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j] + 1
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if prev[j-1]+cost < cur[j] {
+				cur[j] = prev[j-1] + cost
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,344 @@
+package asm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"diagnostic"
+)
+
+// Directives are lines starting with a dot. They are handled by the parser, before labels
+// are given addresses, so skipped and repeated lines count towards ROM addresses correctly.
+//
+//	.ifdef NAME / .ifndef NAME   assemble the block if NAME is (not) defined
+//	.if EXPR                     assemble the block if EXPR is true: a value, or two compared
+//	                             with == != < <= > >=, where a value is a number or a name
+//	.else / .endif
+//	.rept COUNT [COUNTER]        assemble the block up to .endr COUNT times; COUNTER names
+//	                             the iteration, from 0
+//	.endr
+//	.string NAME "text"          store the length and character codes of text in RAM at NAME
+//
+// Names are defined with -D NAME=value on the command line, and @NAME loads the value of a
+// defined name or .rept counter, so the same source can build several variants.
+
+// maxExpanded limits the lines produced by .rept blocks, nested ones included
+const maxExpanded = 1 << 20
+
+// Defines maps names given with -D NAME=value to their values. It implements flag.Value.
+type Defines map[string]int
+
+// String lists the definitions as NAME=value, sorted by name
+func (d Defines) String() string {
+	names := make([]string, 0, len(d))
+	for name := range d {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = name + "=" + strconv.Itoa(d[name])
+	}
+	return strings.Join(names, ",")
+}
+
+// Set adds a NAME=value definition; NAME on its own defines it as 1
+func (d Defines) Set(s string) error {
+	name, value, hasValue := strings.Cut(s, "=")
+	if !validSymbol(name) {
+		return fmt.Errorf("invalid name %q", name)
+	}
+	v := 1
+	if hasValue {
+		var err error
+		if v, err = strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s: value %q is not a number", name, value)
+		}
+	}
+	d[name] = v
+	return nil
+}
+
+// binding is the value of a .rept counter, linked to the counters of enclosing blocks
+type binding struct {
+	name   string
+	value  int
+	parent *binding
+}
+
+// cond is an open .if block
+type cond struct {
+	line, col int
+	active    bool // lines in the block are assembled
+	taken     bool // a branch has been assembled, or the enclosing block is skipped
+	hasElse   bool
+}
+
+// sourceLine is a line of a .rept body, with its place in the source
+type sourceLine struct {
+	text string
+	line int
+}
+
+// rept is a .rept block being replayed
+type rept struct {
+	body    []sourceLine
+	counter string
+	count   int
+	iter    int
+	pos     int
+	parent  *binding // counters in scope at the .rept line
+	env     *binding // parent plus this block's counter for the current iteration
+}
+
+// start begins the current iteration
+func (r *rept) start() {
+	r.pos = 0
+	r.env = r.parent
+	if r.counter != "" {
+		r.env = &binding{name: r.counter, value: r.iter, parent: r.parent}
+	}
+}
+
+// skipping reports whether the current line is in a skipped .if block
+func (p *Parser) skipping() bool {
+	return len(p.conds) > 0 && !p.conds[len(p.conds)-1].active
+}
+
+// lookup returns the value of a .rept counter or defined name
+func (p *Parser) lookup(name string) (int, bool) {
+	for b := p.env; b != nil; b = b.parent {
+		if b.name == name {
+			return b.value, true
+		}
+	}
+	v, ok := p.defines[name]
+	return v, ok
+}
+
+// substitute replaces a defined name in an A-command with its value
+func (p *Parser) substitute(c *Command) bool {
+	v, ok := p.lookup(c.Symbol)
+	if !ok {
+		return true
+	}
+	if v < 0 || v > MaxConstant {
+		p.report(diagnostic.Errorf(c.Line, c.SymCol, "constant-too-large", "%s is %d, which is not between 0 and %d", c.Symbol, v, MaxConstant))
+		return false
+	}
+	c.Symbol = strconv.Itoa(v)
+	c.Value = v
+	return true
+}
+
+// directive handles a line starting with a dot
+func (p *Parser) directive(text string, col int) {
+	fields := strings.Fields(text)
+	name, args := fields[0], fields[1:]
+	if p.skipping() {
+		switch name {
+		case ".if", ".ifdef", ".ifndef":
+			// nested in a skipped block: skip this one too, including any .else
+			p.conds = append(p.conds, cond{line: p.line, col: col, taken: true})
+		case ".else", ".endif":
+			p.endBranch(name, col)
+		}
+		// .rept blocks are skipped line by line, and their .endr with them
+		return
+	}
+
+	switch name {
+	case ".if", ".ifdef", ".ifndef":
+		var ok bool
+		if name == ".if" {
+			ok = p.eval(args, col)
+		} else if p.argCount(name, args, 1, col) {
+			_, ok = p.lookup(args[0])
+			ok = ok == (name == ".ifdef")
+		}
+		p.conds = append(p.conds, cond{line: p.line, col: col, active: ok, taken: ok})
+	case ".else", ".endif":
+		if p.argCount(name, args, 0, col) {
+			p.endBranch(name, col)
+		}
+	case ".rept":
+		p.rept(args, col)
+	case ".string":
+		p.stringData(text[len(name):], col)
+	case ".endr":
+		p.report(diagnostic.Errorf(p.line, col, "unmatched-endr", ".endr without a .rept"))
+	default:
+		p.report(diagnostic.Errorf(p.line, col, "unknown-directive", "unknown directive %s", name).
+			WithFix("use .if, .ifdef, .ifndef, .else, .endif, .rept, .endr or .string"))
+	}
+}
+
+// endBranch handles .else and .endif
+func (p *Parser) endBranch(name string, col int) {
+	if len(p.conds) == 0 {
+		p.report(diagnostic.Errorf(p.line, col, "unmatched-"+name[1:], "%s without an .if", name))
+		return
+	}
+	top := &p.conds[len(p.conds)-1]
+	if name == ".endif" {
+		p.conds = p.conds[:len(p.conds)-1]
+		return
+	}
+	if top.hasElse {
+		p.report(diagnostic.Errorf(p.line, col, "duplicate-else", "the .if on line %d already has an .else", top.line))
+		return
+	}
+	top.hasElse = true
+	top.active = !top.taken
+	top.taken = true
+}
+
+// argCount checks that a directive has n arguments
+func (p *Parser) argCount(name string, args []string, n, col int) bool {
+	if len(args) == n {
+		return true
+	}
+	p.report(diagnostic.Errorf(p.line, col, "wrong-arguments", "%s takes %d argument(s), got %d", name, n, len(args)))
+	return false
+}
+
+// eval works out the condition of an .if
+func (p *Parser) eval(args []string, col int) bool {
+	if len(args) != 1 && len(args) != 3 {
+		p.report(diagnostic.Errorf(p.line, col, "invalid-expression", ".if takes a value or a comparison").
+			WithFix("write .if NAME or .if NAME == value, with spaces around the operator"))
+		return false
+	}
+	x, ok := p.value(args[0], col)
+	if !ok {
+		return false
+	}
+	if len(args) == 1 {
+		return x != 0
+	}
+	y, ok := p.value(args[2], col)
+	if !ok {
+		return false
+	}
+	switch args[1] {
+	case "==":
+		return x == y
+	case "!=":
+		return x != y
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	}
+	p.report(diagnostic.Errorf(p.line, col, "invalid-expression", "unknown operator %s", args[1]).
+		WithFix("compare with == != < <= > or >="))
+	return false
+}
+
+// value reads a number or the value of a defined name
+func (p *Parser) value(arg string, col int) (int, bool) {
+	if v, err := strconv.Atoi(arg); err == nil {
+		return v, true
+	}
+	if v, ok := p.lookup(arg); ok {
+		return v, true
+	}
+	if !validSymbol(arg) {
+		p.report(diagnostic.Errorf(p.line, col, "invalid-expression", "%q is not a number or a name", arg))
+	} else {
+		p.report(diagnostic.Errorf(p.line, col, "undefined-name", "%s is not defined", arg).
+			WithFix("define it with -D %s=value, or test it with .ifdef", arg))
+	}
+	return 0, false
+}
+
+// rept reads the body of a .rept block and starts replaying it
+func (p *Parser) rept(args []string, col int) {
+	line, env := p.line, p.env
+	r := &rept{parent: env}
+	valid := false
+	switch {
+	case len(args) < 1 || len(args) > 2:
+		p.report(diagnostic.Errorf(line, col, "wrong-arguments", ".rept takes a count and an optional counter name"))
+	case len(args) == 2 && !validSymbol(args[1]):
+		p.report(diagnostic.Errorf(line, col, "invalid-symbol", "invalid counter name %q", args[1]))
+	default:
+		r.count, valid = p.value(args[0], col)
+		if valid && (r.count < 0 || r.count > RomSize) {
+			p.report(diagnostic.Errorf(line, col, "invalid-count", ".rept count %d is not between 0 and %d", r.count, RomSize))
+			valid = false
+		}
+		if len(args) == 2 {
+			r.counter = args[1]
+		}
+	}
+	// the body is read even if the .rept is bad, so its .endr is not reported as well
+	body, ok := p.reptBody(line, col)
+	if !valid || !ok || r.count == 0 || len(body) == 0 {
+		return
+	}
+	if p.expanded > maxExpanded {
+		// already reported; the enclosing blocks have been dropped
+		return
+	}
+	p.expanded += r.count * len(body)
+	if p.expanded > maxExpanded {
+		p.report(diagnostic.Errorf(line, col, "rept-too-large", ".rept blocks expand to more than %d lines", maxExpanded))
+		p.repts = nil
+		return
+	}
+	r.body = body
+	r.start()
+	p.repts = append(p.repts, r)
+}
+
+// reptBody collects the lines up to the .endr matching the .rept on line
+func (p *Parser) reptBody(line, col int) ([]sourceLine, bool) {
+	body := []sourceLine{}
+	depth, ifDepth, balanced := 0, 0, true
+	for {
+		text, ok := p.next()
+		if !ok {
+			p.report(diagnostic.Errorf(line, col, "unclosed-rept", ".rept without an .endr").
+				WithFix("add .endr after the lines to repeat"))
+			return nil, false
+		}
+		start, end := Bounds(text)
+		if end > start && text[start] == '.' {
+			switch strings.Fields(text[start:end])[0] {
+			case ".rept":
+				depth++
+			case ".endr":
+				if depth == 0 {
+					if ifDepth != 0 || !balanced {
+						p.report(diagnostic.Errorf(line, col, "unbalanced-if", "an .if in this .rept block is not closed inside it").
+							WithFix("move the .endif inside the .rept block"))
+						return nil, false
+					}
+					return body, true
+				}
+				depth--
+			case ".if", ".ifdef", ".ifndef":
+				ifDepth++
+			case ".endif":
+				ifDepth--
+				balanced = balanced && ifDepth >= 0
+			}
+		}
+		body = append(body, sourceLine{text: text, line: p.line})
+	}
+}
+
+// finish reports blocks left open at the end of the input
+func (p *Parser) finish() {
+	for _, c := range p.conds {
+		p.report(diagnostic.Errorf(c.line, c.col, "unclosed-if", ".if without an .endif").
+			WithFix("add .endif after the conditional lines"))
+	}
+	p.conds = nil
+}
@@ -0,0 +1,51 @@
+package asm
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseWord reads the 16 character binary text of a word, such as a line of a .hack file
+func ParseWord(text string) (uint16, error) {
+	if len(text) != 16 {
+		return 0, fmt.Errorf("%q is not a 16-bit binary word", text)
+	}
+	var word uint16
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '0':
+			word <<= 1
+		case '1':
+			word = word<<1 | 1
+		default:
+			return 0, fmt.Errorf("%q is not a 16-bit binary word", text)
+		}
+	}
+	return word, nil
+}
+
+// Disassemble returns the assembly for one word of binary code, using the standard profile.
+// A-instructions come back as @value, since labels and variables are not in the binary.
+func Disassemble(word uint16) (string, error) {
+	return standard.Disassemble(word)
+}
+
+// Disassemble returns the assembly for one word of binary code
+func (isa *ISA) Disassemble(word uint16) (string, error) {
+	if word&0x8000 == 0 {
+		return "@" + strconv.Itoa(int(word)), nil
+	}
+	// 111 a cccccc ddd jjj on the standard ALU
+	comp, ok := isa.compNames[word>>6]
+	if !ok {
+		return "", fmt.Errorf("%s is not a C-instruction of the %s profile", FormatWord(word), isa.Name)
+	}
+	instr := comp
+	if dest := isa.destNames[word>>3&0x7]; dest != "" {
+		instr = dest + "=" + instr
+	}
+	if jump := isa.jumpNames[word&0x7]; jump != "" {
+		instr += ";" + jump
+	}
+	return instr, nil
+}
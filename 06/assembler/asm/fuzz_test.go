@@ -0,0 +1,41 @@
+package asm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"diagnostic"
+)
+
+// FuzzAssemble feeds arbitrary text to the assembler. It must never panic, and whatever
+// it accepts without errors must survive a disassemble and reassemble round trip.
+func FuzzAssemble(f *testing.F) {
+	seeds, _ := filepath.Glob(filepath.Join("testdata", "*.asm"))
+	for _, name := range seeds {
+		if src, err := os.ReadFile(name); err == nil {
+			f.Add(string(src))
+		}
+	}
+	for _, src := range []string{"", " ", "/", "(", "@", "D=M;JMP", "(X)\n@X\n0;JMP", "AM=M-1\n@var\nM=D"} {
+		f.Add(src)
+	}
+	f.Fuzz(func(t *testing.T, src string) {
+		prog := Assemble(strings.NewReader(src))
+		if diagnostic.HasErrors(prog.Diags) {
+			return
+		}
+		roundTrip(t, src)
+	})
+}
+
+// FuzzRoundTrip assembles random valid programs built by genProgram, then checks that
+// disassembling and reassembling the output gives identical bit patterns.
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 4, 10, 20, 2, 0, 0, 3, 0, 0, 4, 255, 255})
+	f.Add([]byte("the quick brown fox jumps over the lazy dog"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		roundTrip(t, genProgram(data))
+	})
+}
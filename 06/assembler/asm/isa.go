@@ -0,0 +1,202 @@
+package asm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"diagnostic"
+)
+
+// ISA describes a Hack variant: the encodings of the C-instruction fields and the predefined symbols.
+// The standard Hack profile is built in, and LoadISA reads profiles that add to or override it.
+type ISA struct {
+	Name    string
+	Comp    map[string]uint16 // bits 15-6 of the instruction: 111 a cccccc on the standard ALU
+	Dest    map[string]uint16 // bits 5-3
+	Jump    map[string]uint16 // bits 2-0
+	Symbols map[string]int    // predefined symbols and their addresses
+
+	// reverse lookups from bits to mnemonic, for disassembly
+	compNames map[uint16]string
+	destNames map[uint16]string
+	jumpNames map[uint16]string
+}
+
+// standard is the built-in profile, shared by the package-level functions
+var standard = Standard()
+
+// Standard returns the instruction set and predefined symbols of the Hack computer from the course
+func Standard() *ISA {
+	isa := &ISA{
+		Name:    "hack",
+		Comp:    map[string]uint16{},
+		Dest:    map[string]uint16{},
+		Jump:    map[string]uint16{},
+		Symbols: map[string]int{},
+	}
+	for name, bits := range acTable {
+		isa.Comp[name] = 0b111<<7 | bits
+	}
+	for name, bits := range dTable {
+		isa.Dest[name] = bits
+	}
+	for name, bits := range jTable {
+		isa.Jump[name] = bits
+	}
+	for name, addr := range predefinedSymbols {
+		isa.Symbols[name] = addr
+	}
+	for name, code := range keyCodes {
+		isa.Symbols[name] = code
+	}
+	isa.index()
+	return isa
+}
+
+// LoadISAFile reads a profile from the named file
+func LoadISAFile(name string) (*ISA, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	isa, err := LoadISA(f)
+	if d, ok := err.(diagnostic.Diagnostic); ok {
+		d.File = name
+		return nil, d
+	}
+	return isa, err
+}
+
+// LoadISA reads a profile and applies it on top of the standard Hack profile.
+// Each line of a profile is one of
+//
+//	name <profile name>
+//	comp <mnemonic> <bits>     7 bits (a cccccc, with the usual 111 prefix) or 10 bits (prefix a cccccc)
+//	dest <mnemonic> <bits>     3 bits
+//	jump <mnemonic> <bits>     3 bits
+//	symbol <name> <address>
+//
+// and blank lines and // comments are ignored.
+func LoadISA(r io.Reader) (*ISA, error) {
+	isa := Standard()
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.Index(text, "//"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := isa.apply(fields); err != nil {
+			return nil, diagnostic.Errorf(line, 1, "bad-profile", "%v", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	isa.index()
+	return isa, nil
+}
+
+// apply adds one profile entry to the ISA
+func (isa *ISA) apply(fields []string) error {
+	if fields[0] == "name" {
+		isa.Name = strings.Join(fields[1:], " ")
+		return nil
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("%s entry needs a name and a value", fields[0])
+	}
+	name, value := fields[1], fields[2]
+	switch fields[0] {
+	case "comp":
+		if strings.ContainsAny(name, "=;") {
+			return fmt.Errorf("comp mnemonic %q may not contain = or ;", name)
+		}
+		bits, err := strconv.ParseUint(value, 2, 16)
+		switch {
+		case err != nil || (len(value) != 7 && len(value) != 10):
+			return fmt.Errorf("comp %s: %q is not 7 or 10 bits", name, value)
+		case len(value) == 7:
+			bits |= 0b111 << 7
+		case bits>>9 == 0:
+			return fmt.Errorf("comp %s: %s starts with 0, which is an A-instruction", name, value)
+		}
+		isa.Comp[name] = uint16(bits)
+	case "dest", "jump":
+		bits, err := strconv.ParseUint(value, 2, 16)
+		if err != nil || len(value) != 3 {
+			return fmt.Errorf("%s %s: %q is not 3 bits", fields[0], name, value)
+		}
+		if fields[0] == "dest" {
+			isa.Dest[name] = uint16(bits)
+		} else {
+			isa.Jump[name] = uint16(bits)
+		}
+	case "symbol":
+		if !validSymbol(name) {
+			return fmt.Errorf("invalid symbol %q", name)
+		}
+		addr, err := strconv.Atoi(value)
+		if err != nil || addr < 0 || addr > MaxConstant {
+			return fmt.Errorf("symbol %s: address %q is not between 0 and %d", name, value, MaxConstant)
+		}
+		isa.Symbols[name] = addr
+	default:
+		return fmt.Errorf("unknown entry %q, want name, comp, dest, jump or symbol", fields[0])
+	}
+	return nil
+}
+
+// index builds the reverse lookups used by Disassemble
+func (isa *ISA) index() {
+	isa.compNames = invert(isa.Comp)
+	isa.destNames = invert(isa.Dest)
+	isa.jumpNames = invert(isa.Jump)
+}
+
+// invert maps bits back to mnemonics; when two mnemonics share bits, the shorter (then first) name wins
+func invert(table map[string]uint16) map[uint16]string {
+	inv := map[uint16]string{}
+	for name, bits := range table {
+		if old, ok := inv[bits]; !ok || len(name) < len(old) || (len(name) == len(old) && name < old) {
+			inv[bits] = name
+		}
+	}
+	return inv
+}
+
+// CompMnemonics returns the comp table, sorted by name.
+// Bits are the 7 a-cccccc bits, or all 10 bits when the prefix is not 111.
+func (isa *ISA) CompMnemonics() []Mnemonic {
+	m := []Mnemonic{}
+	for name, bits := range isa.Comp {
+		text := fmt.Sprintf("%010b", bits)
+		if bits>>7 == 0b111 {
+			text = text[3:]
+		}
+		m = append(m, Mnemonic{Name: name, Bits: text})
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i].Name < m[j].Name })
+	return m
+}
+
+// DestMnemonics returns the dest table, sorted by name
+func (isa *ISA) DestMnemonics() []Mnemonic {
+	return mnemonics(isa.Dest, 3)
+}
+
+// JumpMnemonics returns the jump table, sorted by name
+func (isa *ISA) JumpMnemonics() []Mnemonic {
+	return mnemonics(isa.Jump, 3)
+}
@@ -0,0 +1,123 @@
+package asm
+
+import (
+	"strings"
+
+	"diagnostic"
+)
+
+// Lint checks, each reported as a warning with its code:
+//
+//	m-and-jump  a C-instruction uses M and jumps, so A is both the data address and the jump target
+//	am-write    AM=... or AMD=... writes M at the old A, not at the address being loaded into A
+//	stale-a     A is used without an A-instruction since it last changed meaning: at the start of
+//	            the program, after a label that is jumped to, or after a jump, where A holds a ROM
+//	            address rather than data
+//
+// A warning is suppressed by a "lint:ignore" comment on the same line, followed by the codes to
+// ignore, separated by commas; with no codes, every warning on the line is ignored.
+//
+//	AM=M-1 // lint:ignore am-write
+
+// aState is what the linter knows about A before an instruction
+type aState int
+
+const (
+	aData    aState = iota // set by an A-instruction or a computation
+	aUnknown               // start of the program, or a label that is jumped to
+	aCode                  // still the target of the jump just taken or not taken
+)
+
+// Lint returns warnings about instructions that misuse A
+func (p *Program) Lint() []diagnostic.Diagnostic {
+	diags := []diagnostic.Diagnostic{}
+	warn := func(c *Command, code, fix, format string, args ...interface{}) {
+		if !p.ignored(c.Line, code) {
+			diags = append(diags, diagnostic.Warningf(c.Line, c.Col, code, format, args...).WithFix("%s", fix))
+		}
+	}
+
+	jumpedTo := map[string]bool{}
+	for i := range p.Commands {
+		if c := &p.Commands[i]; c.Type == ACommand {
+			jumpedTo[c.Symbol] = true
+		}
+	}
+
+	state, entry := aUnknown, ""
+	for i := range p.Commands {
+		c := &p.Commands[i]
+		switch c.Type {
+		case LCommand:
+			// a label no one loads can only be reached by falling into it
+			if jumpedTo[c.Symbol] {
+				state, entry = aUnknown, c.Symbol
+			}
+		case ACommand:
+			state = aData
+		case CCommand:
+			usesM := strings.IndexByte(c.Comp, 'M') >= 0 || strings.IndexByte(c.Dest, 'M') >= 0
+			readsA := strings.IndexByte(c.Comp, 'A') >= 0
+			setsA := strings.IndexByte(c.Dest, 'A') >= 0
+			jumps := c.Jump != ""
+
+			if usesM && jumps {
+				warn(c, "m-and-jump", "compute the value into D first, then load the jump target with its own A-instruction",
+					"%s uses M and jumps, so A is both the RAM address and the jump target", c.Text)
+			}
+			if setsA && strings.IndexByte(c.Dest, 'M') >= 0 {
+				warn(c, "am-write", "check the old address is the one meant, or split the A and M writes",
+					"%s writes M at the address A held before this instruction, not at the new A", c.Text)
+			}
+			const reload = "load the address with an A-instruction first"
+			switch {
+			case state == aUnknown && (usesM || readsA || jumps) && entry == "":
+				warn(c, "stale-a", reload, "%s uses A before any A-instruction has set it", c.Text)
+			case state == aUnknown && (usesM || readsA || jumps):
+				warn(c, "stale-a", reload, "%s uses A right after (%s), where A holds %s's ROM address when arriving by a jump", c.Text, entry, entry)
+			case state == aCode && (usesM || readsA):
+				warn(c, "stale-a", reload, "%s uses A as data, but it still holds the ROM address of the previous jump", c.Text)
+			}
+			switch {
+			case setsA:
+				state = aData
+			case jumps:
+				state = aCode
+			}
+		}
+	}
+	return diags
+}
+
+// ignored reports whether a lint:ignore comment on line suppresses code
+func (p *Program) ignored(line int, code string) bool {
+	codes, ok := p.ignores[line]
+	if !ok {
+		return false
+	}
+	if len(codes) == 0 {
+		return true
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// noteIgnore records a lint:ignore comment in the text after a command
+func (p *Parser) noteIgnore(comment string) {
+	i := strings.Index(comment, "lint:ignore")
+	if i < 0 {
+		return
+	}
+	codes := []string{}
+	if fields := strings.Fields(comment[i+len("lint:ignore"):]); len(fields) > 0 {
+		codes = strings.Split(fields[0], ",")
+	}
+	if p.ignores == nil {
+		p.ignores = map[int][]string{}
+	}
+	p.ignores[p.line] = codes
+}
@@ -0,0 +1,320 @@
+// Package asm translates Hack assembly language to Hack binary code.
+// It follows the Parser / Code / SymbolTable design from the course.
+package asm
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"diagnostic"
+)
+
+// CommandType identifies the kind of a parsed command
+type CommandType int
+
+const (
+	// ACommand is @Xxx, where Xxx is a symbol or a decimal number
+	ACommand CommandType = iota
+	// CCommand is dest=comp;jump
+	CCommand
+	// LCommand is the (Xxx) label pseudo-command
+	LCommand
+)
+
+// Command holds one parsed line of assembly
+type Command struct {
+	Type   CommandType
+	Line   int    // source line, starting at 1
+	Col    int    // column of the first character of the command, starting at 1
+	SymCol int    // column of the symbol of an A- or L-command
+	Text   string // the command with comments and surrounding whitespace removed
+	Symbol string // symbol or decimal of an A-command, or label of an L-command
+	Dest   string
+	Comp   string
+	Jump   string
+	Value  int // value of an A-command whose symbol is a decimal number
+	Offset int // added to the address of the symbol, for the words of a .string
+	Addr   int // ROM address of the command; for labels, the address of the next instruction
+}
+
+// Parser holds the input stream for parsing and the current command
+type Parser struct {
+	scanner  *bufio.Scanner
+	read     int // lines read from the input
+	line     int // source line of the current command
+	current  Command
+	diags    []diagnostic.Diagnostic
+	defines  Defines
+	conds    []cond  // open .if blocks, innermost last
+	repts    []*rept // .rept blocks being replayed, innermost last
+	env      *binding
+	expanded int
+	pending  []Command     // commands produced by a directive, not yet returned
+	blocks   []stringBlock // RAM reserved by .string
+	ignores  map[int][]string
+}
+
+// NewParser creates a new Parser
+func NewParser(r io.Reader) *Parser {
+	p := &Parser{
+		scanner: bufio.NewScanner(r),
+	}
+	p.scanner.Buffer(make([]byte, 64*1024), bufio.MaxScanTokenSize)
+	return p
+}
+
+// Advance reads the next command from the input, skipping blank lines and comments.
+// It returns false when there are no more commands.
+// Directives (.if, .rept and so on) are handled here, so the caller only sees the commands
+// that are assembled.
+func (p *Parser) Advance() bool {
+	for {
+		if len(p.pending) > 0 {
+			p.current = p.pending[0]
+			p.pending = p.pending[1:]
+			return true
+		}
+		line, ok := p.next()
+		if !ok {
+			p.finish()
+			return false
+		}
+		start, end := Bounds(line)
+		if start == end {
+			continue
+		}
+		if end < len(line) {
+			p.noteIgnore(line[end:])
+		}
+		if line[start] == '.' {
+			p.directive(line[start:end], start+1)
+			continue
+		}
+		if p.skipping() {
+			continue
+		}
+		if c, ok := p.parse(line[start:end], start+1); ok {
+			p.current = c
+			return true
+		}
+	}
+}
+
+// next returns the next source line, replaying .rept bodies before reading on
+func (p *Parser) next() (string, bool) {
+	for len(p.repts) > 0 {
+		r := p.repts[len(p.repts)-1]
+		if r.pos == len(r.body) {
+			r.iter++
+			if r.iter == r.count {
+				p.repts = p.repts[:len(p.repts)-1]
+				continue
+			}
+			r.start()
+		}
+		l := r.body[r.pos]
+		r.pos++
+		p.line, p.env = l.line, r.env
+		return l.text, true
+	}
+	if !p.scanner.Scan() {
+		p.line, p.env = p.read, nil
+		return "", false
+	}
+	p.read++
+	p.line, p.env = p.read, nil
+	return p.scanner.Text(), true
+}
+
+// Command returns the current command
+func (p *Parser) Command() Command {
+	return p.current
+}
+
+// Diagnostics returns the syntax errors found so far
+func (p *Parser) Diagnostics() []diagnostic.Diagnostic {
+	if err := p.scanner.Err(); err != nil {
+		return append(p.diags, diagnostic.Errorf(p.line, 0, "read-error", "%v", err))
+	}
+	return p.diags
+}
+
+// character classes used by the lexer, indexed by byte
+const (
+	classOther byte = iota
+	classSpace
+	classDigit
+	classSymbol // letters, underscore, dot, dollar sign and colon
+)
+
+var charClass [256]byte
+
+func init() {
+	for ch := 0; ch < 256; ch++ {
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r' || ch == '\v' || ch == '\f':
+			charClass[ch] = classSpace
+		case ch >= '0' && ch <= '9':
+			charClass[ch] = classDigit
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch == '_', ch == '.', ch == '$', ch == ':':
+			charClass[ch] = classSymbol
+		}
+	}
+}
+
+// Bounds returns where the command in line starts and ends, leaving out whitespace and comments.
+// // inside quotes does not start a comment.
+func Bounds(line string) (int, int) {
+	start, end := -1, -1
+	var quote byte
+scan:
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case quote != 0:
+			if ch == '\\' && i+1 < len(line) {
+				i++
+				end = i + 1
+				continue
+			}
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '/' && i+1 < len(line) && line[i+1] == '/':
+			break scan
+		}
+		if charClass[ch] != classSpace {
+			if start < 0 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	if start < 0 {
+		return 0, 0
+	}
+	return start, end
+}
+
+// parse splits a command into its fields in one pass, recording an error if it is malformed
+func (p *Parser) parse(text string, col int) (Command, bool) {
+	c := Command{
+		Line: p.line,
+		Col:  col,
+		Text: text,
+	}
+
+	switch text[0] {
+	case '@':
+		c.Type = ACommand
+		start, end := Bounds(text[1:])
+		c.Symbol = text[1+start : 1+end]
+		c.SymCol = col + 1 + start
+		if c.Symbol == "" {
+			p.report(diagnostic.Errorf(c.Line, col, "missing-value", "missing symbol or value after @"))
+			return c, false
+		}
+		if charClass[c.Symbol[0]] == classDigit {
+			return c, p.parseConstant(&c)
+		}
+		if c.Symbol[0] == '\'' {
+			return c, p.parseChar(&c)
+		}
+		if !validSymbol(c.Symbol) {
+			p.report(diagnostic.Errorf(c.Line, c.SymCol, "invalid-symbol", "invalid symbol %q", c.Symbol).
+				WithFix("symbols may only use letters, digits, _ . $ and :"))
+			return c, false
+		}
+		if p.env != nil || len(p.defines) > 0 {
+			return c, p.substitute(&c)
+		}
+	case '(':
+		c.Type = LCommand
+		if text[len(text)-1] != ')' {
+			p.report(diagnostic.Errorf(c.Line, col, "unclosed-label", "label %s is missing a closing parenthesis", text).
+				WithFix("%s)", text))
+			return c, false
+		}
+		start, end := Bounds(text[1 : len(text)-1])
+		c.Symbol = text[1+start : 1+end]
+		c.SymCol = col + 1 + start
+		if !validSymbol(c.Symbol) {
+			p.report(diagnostic.Errorf(c.Line, c.SymCol, "invalid-label", "invalid label %q", c.Symbol).
+				WithFix("labels may only use letters, digits, _ . $ and :, and may not start with a digit"))
+			return c, false
+		}
+	default:
+		// dest = comp; jump
+		c.Type = CCommand
+		eq, semi, spaces := -1, -1, false
+		for i := 0; i < len(text); i++ {
+			switch {
+			case text[i] == '=' && eq < 0 && semi < 0:
+				eq = i
+			case text[i] == ';' && semi < 0:
+				semi = i
+			case charClass[text[i]] == classSpace:
+				spaces = true
+			}
+		}
+		if spaces {
+			// whitespace inside a C-command is ignored
+			c.Text = strings.Join(strings.Fields(text), "")
+			return p.parse(c.Text, col)
+		}
+		comp := text
+		if semi >= 0 {
+			c.Jump = text[semi+1:]
+			comp = text[:semi]
+		}
+		if eq >= 0 {
+			c.Dest = text[:eq]
+			comp = comp[eq+1:]
+		}
+		c.Comp = comp
+	}
+	return c, true
+}
+
+// parseConstant reads the decimal value of an A-command
+func (p *Parser) parseConstant(c *Command) bool {
+	v := 0
+	for i := 0; i < len(c.Symbol); i++ {
+		if charClass[c.Symbol[i]] != classDigit {
+			p.report(diagnostic.Errorf(c.Line, c.SymCol, "invalid-constant", "invalid constant %q", c.Symbol).
+				WithFix("use a decimal number, or a symbol that does not start with a digit"))
+			return false
+		}
+		if v <= MaxConstant {
+			v = v*10 + int(c.Symbol[i]-'0')
+		}
+	}
+	if v > MaxConstant {
+		p.report(diagnostic.Errorf(c.Line, c.SymCol, "constant-too-large", "constant %s is larger than %d", c.Symbol, MaxConstant).
+			WithFix("load a value between 0 and %d, and build larger values with D", MaxConstant))
+		return false
+	}
+	c.Value = v
+	return true
+}
+
+func (p *Parser) report(d diagnostic.Diagnostic) {
+	p.diags = append(p.diags, d)
+}
+
+// validSymbol reports whether s is a sequence of letters, digits, underscore, dot,
+// dollar sign and colon that does not begin with a digit
+func validSymbol(s string) bool {
+	if s == "" || charClass[s[0]] != classSymbol {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if class := charClass[s[i]]; class != classSymbol && class != classDigit {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package asm
+
+import "sort"
+
+// SymbolKind tells where a symbol came from
+type SymbolKind int
+
+const (
+	// Predefined symbols are built into the assembler (R0-R15, SP, SCREEN, ...)
+	Predefined SymbolKind = iota
+	// Label symbols are declared with (Xxx) and refer to ROM addresses
+	Label
+	// Variable symbols are allocated RAM addresses starting at 16
+	Variable
+)
+
+func (k SymbolKind) String() string {
+	switch k {
+	case Predefined:
+		return "predefined"
+	case Label:
+		return "label"
+	case Variable:
+		return "variable"
+	}
+	return "unknown"
+}
+
+// Symbol is an entry in the SymbolTable
+type Symbol struct {
+	Name    string
+	Address int
+	Kind    SymbolKind
+	Line    int // where a label is declared or a variable is first used; 0 for predefined symbols
+	Col     int
+}
+
+// SymbolTable maps symbols to their ROM or RAM addresses
+type SymbolTable struct {
+	symbols map[string]*Symbol
+	nextVar int
+}
+
+// predefinedSymbols are the symbols of the standard Hack profile
+var predefinedSymbols = map[string]int{
+	"R0":     0,
+	"R1":     1,
+	"R2":     2,
+	"R3":     3,
+	"R4":     4,
+	"R5":     5,
+	"R6":     6,
+	"R7":     7,
+	"R8":     8,
+	"R9":     9,
+	"R10":    10,
+	"R11":    11,
+	"R12":    12,
+	"R13":    13,
+	"R14":    14,
+	"R15":    15,
+	"SP":     0,
+	"LCL":    1,
+	"ARG":    2,
+	"THIS":   3,
+	"THAT":   4,
+	"SCREEN": 16384,
+	"KBD":    24576,
+}
+
+// NewSymbolTable creates a SymbolTable holding the predefined symbols of the standard profile
+func NewSymbolTable() *SymbolTable {
+	return standard.NewSymbolTable()
+}
+
+// NewSymbolTable creates a SymbolTable holding the predefined symbols of the profile
+func (isa *ISA) NewSymbolTable() *SymbolTable {
+	s := &SymbolTable{
+		symbols: map[string]*Symbol{},
+		nextVar: 16,
+	}
+	for name, addr := range isa.Symbols {
+		s.symbols[name] = &Symbol{Name: name, Address: addr, Kind: Predefined}
+	}
+	return s
+}
+
+// AddEntry adds a symbol to the table
+func (s *SymbolTable) AddEntry(sym *Symbol) {
+	s.symbols[sym.Name] = sym
+}
+
+// AddVariable allocates the next free RAM address to a new variable and returns it
+func (s *SymbolTable) AddVariable(name string, line, col int) *Symbol {
+	sym := &Symbol{Name: name, Address: s.nextVar, Kind: Variable, Line: line, Col: col}
+	s.AddEntry(sym)
+	s.nextVar++
+	return sym
+}
+
+// AddBlock allocates size consecutive RAM addresses to a new variable and returns it
+func (s *SymbolTable) AddBlock(name string, size, line, col int) *Symbol {
+	sym := &Symbol{Name: name, Address: s.nextVar, Kind: Variable, Line: line, Col: col}
+	s.AddEntry(sym)
+	s.nextVar += size
+	return sym
+}
+
+// Contains reports whether the table holds the symbol
+func (s *SymbolTable) Contains(name string) bool {
+	_, ok := s.symbols[name]
+	return ok
+}
+
+// GetAddress returns the address of the symbol
+func (s *SymbolTable) GetAddress(name string) int {
+	return s.symbols[name].Address
+}
+
+// Lookup returns the symbol with the given name
+func (s *SymbolTable) Lookup(name string) (*Symbol, bool) {
+	sym, ok := s.symbols[name]
+	return sym, ok
+}
+
+// Symbols returns every symbol in the table, sorted by name
+func (s *SymbolTable) Symbols() []*Symbol {
+	syms := []*Symbol{}
+	for _, sym := range s.symbols {
+		syms = append(syms, sym)
+	}
+	sort.Slice(syms, func(i, j int) bool { return syms[i].Name < syms[j].Name })
+	return syms
+}
@@ -0,0 +1,77 @@
+package asm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteSymbols writes the labels and variables of the program, one per line as
+// "<kind> <name> <address>", sorted by kind and address. Other tools, such as hackdiff,
+// read the file back with ReadSymbols to name ROM and RAM addresses.
+func (p *Program) WriteSymbols(w io.Writer) error {
+	syms := []*Symbol{}
+	for _, sym := range p.Symbols.Symbols() {
+		if sym.Kind != Predefined {
+			syms = append(syms, sym)
+		}
+	}
+	sortByAddress(syms)
+	bw := bufio.NewWriter(w)
+	for _, sym := range syms {
+		fmt.Fprintf(bw, "%s %s %d\n", sym.Kind, sym.Name, sym.Address)
+	}
+	return bw.Flush()
+}
+
+// ReadSymbols reads a symbol file written by WriteSymbols
+func ReadSymbols(r io.Reader) ([]*Symbol, error) {
+	syms := []*Symbol{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: want <kind> <name> <address>", line)
+		}
+		sym := &Symbol{Name: fields[1]}
+		switch fields[0] {
+		case "label":
+			sym.Kind = Label
+		case "variable":
+			sym.Kind = Variable
+		case "predefined":
+			sym.Kind = Predefined
+		default:
+			return nil, fmt.Errorf("line %d: unknown symbol kind %q", line, fields[0])
+		}
+		addr, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid address %q", line, fields[2])
+		}
+		sym.Address = addr
+		syms = append(syms, sym)
+	}
+	return syms, scanner.Err()
+}
+
+// sortByAddress orders symbols by kind, then address, then name
+func sortByAddress(syms []*Symbol) {
+	sort.Slice(syms, func(i, j int) bool {
+		a, b := syms[i], syms[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Name < b.Name
+	})
+}
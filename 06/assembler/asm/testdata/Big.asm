@@ -0,0 +1,32201 @@
+// initialize program state
+(bootstrap)
+	@256
+	D=A
+	@SP
+	M=D
+	@Sys.init$ret0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@5
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Sys.init
+	0;JMP
+(Sys.init$ret0)
+// function Ball.f0 2
+(Ball.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_0
+(LOOP_BALL_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Ball.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_0
+	D;JGT
+	@R13
+	M=0
+(EVAL_0)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_1
+	D;JLT
+	@R13
+	M=0
+(EVAL_1)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f1 1
+	@Ball.f1$ret1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f1
+	0;JMP
+(Ball.f1$ret1)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_0
+	@DONE_BALL_0
+	0;JMP
+// label SMALL_BALL_0
+(SMALL_BALL_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_0
+(DONE_BALL_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_2
+	D;JEQ
+	@R13
+	M=0
+(EVAL_2)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f1 2
+(Ball.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 7
+	@7
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_1
+(LOOP_BALL_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Ball.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_3
+	D;JGT
+	@R13
+	M=0
+(EVAL_3)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_4
+	D;JLT
+	@R13
+	M=0
+(EVAL_4)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f2 1
+	@Ball.f2$ret2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f2
+	0;JMP
+(Ball.f2$ret2)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_1
+	@DONE_BALL_1
+	0;JMP
+// label SMALL_BALL_1
+(SMALL_BALL_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_1
+(DONE_BALL_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_5
+	D;JEQ
+	@R13
+	M=0
+(EVAL_5)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f2 2
+(Ball.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 14
+	@14
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_2
+(LOOP_BALL_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Ball.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_6
+	D;JGT
+	@R13
+	M=0
+(EVAL_6)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_7
+	D;JLT
+	@R13
+	M=0
+(EVAL_7)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f3 1
+	@Ball.f3$ret3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f3
+	0;JMP
+(Ball.f3$ret3)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_2
+	@DONE_BALL_2
+	0;JMP
+// label SMALL_BALL_2
+(SMALL_BALL_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_2
+(DONE_BALL_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_8
+	D;JEQ
+	@R13
+	M=0
+(EVAL_8)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f3 2
+(Ball.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 21
+	@21
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_3
+(LOOP_BALL_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Ball.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_9
+	D;JGT
+	@R13
+	M=0
+(EVAL_9)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_10
+	D;JLT
+	@R13
+	M=0
+(EVAL_10)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f4 1
+	@Ball.f4$ret4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f4
+	0;JMP
+(Ball.f4$ret4)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_3
+	@DONE_BALL_3
+	0;JMP
+// label SMALL_BALL_3
+(SMALL_BALL_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_3
+(DONE_BALL_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_11
+	D;JEQ
+	@R13
+	M=0
+(EVAL_11)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f4 2
+(Ball.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 28
+	@28
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_4
+(LOOP_BALL_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Ball.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_12
+	D;JGT
+	@R13
+	M=0
+(EVAL_12)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_13
+	D;JLT
+	@R13
+	M=0
+(EVAL_13)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f5 1
+	@Ball.f5$ret5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f5
+	0;JMP
+(Ball.f5$ret5)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_4
+	@DONE_BALL_4
+	0;JMP
+// label SMALL_BALL_4
+(SMALL_BALL_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_4
+(DONE_BALL_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_14
+	D;JEQ
+	@R13
+	M=0
+(EVAL_14)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f5 2
+(Ball.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 35
+	@35
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_5
+(LOOP_BALL_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Ball.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_15
+	D;JGT
+	@R13
+	M=0
+(EVAL_15)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_16
+	D;JLT
+	@R13
+	M=0
+(EVAL_16)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f6 1
+	@Ball.f6$ret6
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f6
+	0;JMP
+(Ball.f6$ret6)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_5
+	@DONE_BALL_5
+	0;JMP
+// label SMALL_BALL_5
+(SMALL_BALL_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_5
+(DONE_BALL_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_17
+	D;JEQ
+	@R13
+	M=0
+(EVAL_17)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f6 2
+(Ball.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 42
+	@42
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_6
+(LOOP_BALL_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Ball.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_18
+	D;JGT
+	@R13
+	M=0
+(EVAL_18)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_19
+	D;JLT
+	@R13
+	M=0
+(EVAL_19)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f7 1
+	@Ball.f7$ret7
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f7
+	0;JMP
+(Ball.f7$ret7)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_6
+	@DONE_BALL_6
+	0;JMP
+// label SMALL_BALL_6
+(SMALL_BALL_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_6
+(DONE_BALL_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_20
+	D;JEQ
+	@R13
+	M=0
+(EVAL_20)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f7 2
+(Ball.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 49
+	@49
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_7
+(LOOP_BALL_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Ball.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_21
+	D;JGT
+	@R13
+	M=0
+(EVAL_21)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_22
+	D;JLT
+	@R13
+	M=0
+(EVAL_22)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f8 1
+	@Ball.f8$ret8
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f8
+	0;JMP
+(Ball.f8$ret8)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_7
+	@DONE_BALL_7
+	0;JMP
+// label SMALL_BALL_7
+(SMALL_BALL_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_7
+(DONE_BALL_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_23
+	D;JEQ
+	@R13
+	M=0
+(EVAL_23)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f8 2
+(Ball.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 56
+	@56
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_8
+(LOOP_BALL_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Ball.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_24
+	D;JGT
+	@R13
+	M=0
+(EVAL_24)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_25
+	D;JLT
+	@R13
+	M=0
+(EVAL_25)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f9 1
+	@Ball.f9$ret9
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f9
+	0;JMP
+(Ball.f9$ret9)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BALL_8
+	@DONE_BALL_8
+	0;JMP
+// label SMALL_BALL_8
+(SMALL_BALL_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_8
+(DONE_BALL_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_26
+	D;JEQ
+	@R13
+	M=0
+(EVAL_26)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Ball.f9 2
+(Ball.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 63
+	@63
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BALL_9
+(LOOP_BALL_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Ball.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Ball.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_27
+	D;JGT
+	@R13
+	M=0
+(EVAL_27)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BALL_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BALL_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_28
+	D;JLT
+	@R13
+	M=0
+(EVAL_28)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BALL_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BALL_9
+	D;JNE
+// goto DONE_BALL_9
+	@DONE_BALL_9
+	0;JMP
+// label SMALL_BALL_9
+(SMALL_BALL_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BALL_9
+(DONE_BALL_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_29
+	D;JEQ
+	@R13
+	M=0
+(EVAL_29)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f0 2
+(Bat.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_0
+(LOOP_BAT_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Bat.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_30
+	D;JGT
+	@R13
+	M=0
+(EVAL_30)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_31
+	D;JLT
+	@R13
+	M=0
+(EVAL_31)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f1 1
+	@Bat.f1$ret10
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f1
+	0;JMP
+(Bat.f1$ret10)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_0
+	@DONE_BAT_0
+	0;JMP
+// label SMALL_BAT_0
+(SMALL_BAT_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_0
+(DONE_BAT_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_32
+	D;JEQ
+	@R13
+	M=0
+(EVAL_32)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f1 2
+(Bat.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 8
+	@8
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_1
+(LOOP_BAT_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Bat.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_33
+	D;JGT
+	@R13
+	M=0
+(EVAL_33)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_34
+	D;JLT
+	@R13
+	M=0
+(EVAL_34)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f2 1
+	@Bat.f2$ret11
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f2
+	0;JMP
+(Bat.f2$ret11)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_1
+	@DONE_BAT_1
+	0;JMP
+// label SMALL_BAT_1
+(SMALL_BAT_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_1
+(DONE_BAT_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_35
+	D;JEQ
+	@R13
+	M=0
+(EVAL_35)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f2 2
+(Bat.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 15
+	@15
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_2
+(LOOP_BAT_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Bat.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_36
+	D;JGT
+	@R13
+	M=0
+(EVAL_36)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_37
+	D;JLT
+	@R13
+	M=0
+(EVAL_37)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f3 1
+	@Bat.f3$ret12
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f3
+	0;JMP
+(Bat.f3$ret12)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_2
+	@DONE_BAT_2
+	0;JMP
+// label SMALL_BAT_2
+(SMALL_BAT_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_2
+(DONE_BAT_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_38
+	D;JEQ
+	@R13
+	M=0
+(EVAL_38)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f3 2
+(Bat.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 22
+	@22
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_3
+(LOOP_BAT_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Bat.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_39
+	D;JGT
+	@R13
+	M=0
+(EVAL_39)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_40
+	D;JLT
+	@R13
+	M=0
+(EVAL_40)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f4 1
+	@Bat.f4$ret13
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f4
+	0;JMP
+(Bat.f4$ret13)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_3
+	@DONE_BAT_3
+	0;JMP
+// label SMALL_BAT_3
+(SMALL_BAT_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_3
+(DONE_BAT_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_41
+	D;JEQ
+	@R13
+	M=0
+(EVAL_41)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f4 2
+(Bat.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 29
+	@29
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_4
+(LOOP_BAT_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Bat.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_42
+	D;JGT
+	@R13
+	M=0
+(EVAL_42)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_43
+	D;JLT
+	@R13
+	M=0
+(EVAL_43)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f5 1
+	@Bat.f5$ret14
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f5
+	0;JMP
+(Bat.f5$ret14)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_4
+	@DONE_BAT_4
+	0;JMP
+// label SMALL_BAT_4
+(SMALL_BAT_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_4
+(DONE_BAT_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_44
+	D;JEQ
+	@R13
+	M=0
+(EVAL_44)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f5 2
+(Bat.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 36
+	@36
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_5
+(LOOP_BAT_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Bat.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_45
+	D;JGT
+	@R13
+	M=0
+(EVAL_45)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_46
+	D;JLT
+	@R13
+	M=0
+(EVAL_46)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f6 1
+	@Bat.f6$ret15
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f6
+	0;JMP
+(Bat.f6$ret15)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_5
+	@DONE_BAT_5
+	0;JMP
+// label SMALL_BAT_5
+(SMALL_BAT_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_5
+(DONE_BAT_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_47
+	D;JEQ
+	@R13
+	M=0
+(EVAL_47)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f6 2
+(Bat.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 43
+	@43
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_6
+(LOOP_BAT_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Bat.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_48
+	D;JGT
+	@R13
+	M=0
+(EVAL_48)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_49
+	D;JLT
+	@R13
+	M=0
+(EVAL_49)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f7 1
+	@Bat.f7$ret16
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f7
+	0;JMP
+(Bat.f7$ret16)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_6
+	@DONE_BAT_6
+	0;JMP
+// label SMALL_BAT_6
+(SMALL_BAT_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_6
+(DONE_BAT_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_50
+	D;JEQ
+	@R13
+	M=0
+(EVAL_50)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f7 2
+(Bat.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 50
+	@50
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_7
+(LOOP_BAT_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Bat.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_51
+	D;JGT
+	@R13
+	M=0
+(EVAL_51)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_52
+	D;JLT
+	@R13
+	M=0
+(EVAL_52)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f8 1
+	@Bat.f8$ret17
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f8
+	0;JMP
+(Bat.f8$ret17)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_7
+	@DONE_BAT_7
+	0;JMP
+// label SMALL_BAT_7
+(SMALL_BAT_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_7
+(DONE_BAT_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_53
+	D;JEQ
+	@R13
+	M=0
+(EVAL_53)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f8 2
+(Bat.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 57
+	@57
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_8
+(LOOP_BAT_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Bat.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_54
+	D;JGT
+	@R13
+	M=0
+(EVAL_54)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_55
+	D;JLT
+	@R13
+	M=0
+(EVAL_55)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f9 1
+	@Bat.f9$ret18
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f9
+	0;JMP
+(Bat.f9$ret18)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BAT_8
+	@DONE_BAT_8
+	0;JMP
+// label SMALL_BAT_8
+(SMALL_BAT_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_8
+(DONE_BAT_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_56
+	D;JEQ
+	@R13
+	M=0
+(EVAL_56)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Bat.f9 2
+(Bat.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 64
+	@64
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BAT_9
+(LOOP_BAT_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Bat.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Bat.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_57
+	D;JGT
+	@R13
+	M=0
+(EVAL_57)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BAT_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BAT_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_58
+	D;JLT
+	@R13
+	M=0
+(EVAL_58)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BAT_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BAT_9
+	D;JNE
+// goto DONE_BAT_9
+	@DONE_BAT_9
+	0;JMP
+// label SMALL_BAT_9
+(SMALL_BAT_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BAT_9
+(DONE_BAT_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_59
+	D;JEQ
+	@R13
+	M=0
+(EVAL_59)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f0 2
+(Board.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_0
+(LOOP_BOARD_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Board.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_60
+	D;JGT
+	@R13
+	M=0
+(EVAL_60)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_61
+	D;JLT
+	@R13
+	M=0
+(EVAL_61)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f1 1
+	@Board.f1$ret19
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f1
+	0;JMP
+(Board.f1$ret19)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_0
+	@DONE_BOARD_0
+	0;JMP
+// label SMALL_BOARD_0
+(SMALL_BOARD_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_0
+(DONE_BOARD_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_62
+	D;JEQ
+	@R13
+	M=0
+(EVAL_62)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f1 2
+(Board.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 9
+	@9
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_1
+(LOOP_BOARD_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Board.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_63
+	D;JGT
+	@R13
+	M=0
+(EVAL_63)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_64
+	D;JLT
+	@R13
+	M=0
+(EVAL_64)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f2 1
+	@Board.f2$ret20
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f2
+	0;JMP
+(Board.f2$ret20)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_1
+	@DONE_BOARD_1
+	0;JMP
+// label SMALL_BOARD_1
+(SMALL_BOARD_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_1
+(DONE_BOARD_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_65
+	D;JEQ
+	@R13
+	M=0
+(EVAL_65)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f2 2
+(Board.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 16
+	@16
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_2
+(LOOP_BOARD_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Board.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_66
+	D;JGT
+	@R13
+	M=0
+(EVAL_66)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_67
+	D;JLT
+	@R13
+	M=0
+(EVAL_67)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f3 1
+	@Board.f3$ret21
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f3
+	0;JMP
+(Board.f3$ret21)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_2
+	@DONE_BOARD_2
+	0;JMP
+// label SMALL_BOARD_2
+(SMALL_BOARD_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_2
+(DONE_BOARD_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_68
+	D;JEQ
+	@R13
+	M=0
+(EVAL_68)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f3 2
+(Board.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 23
+	@23
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_3
+(LOOP_BOARD_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Board.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_69
+	D;JGT
+	@R13
+	M=0
+(EVAL_69)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_70
+	D;JLT
+	@R13
+	M=0
+(EVAL_70)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f4 1
+	@Board.f4$ret22
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f4
+	0;JMP
+(Board.f4$ret22)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_3
+	@DONE_BOARD_3
+	0;JMP
+// label SMALL_BOARD_3
+(SMALL_BOARD_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_3
+(DONE_BOARD_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_71
+	D;JEQ
+	@R13
+	M=0
+(EVAL_71)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f4 2
+(Board.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 30
+	@30
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_4
+(LOOP_BOARD_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Board.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_72
+	D;JGT
+	@R13
+	M=0
+(EVAL_72)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_73
+	D;JLT
+	@R13
+	M=0
+(EVAL_73)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f5 1
+	@Board.f5$ret23
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f5
+	0;JMP
+(Board.f5$ret23)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_4
+	@DONE_BOARD_4
+	0;JMP
+// label SMALL_BOARD_4
+(SMALL_BOARD_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_4
+(DONE_BOARD_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_74
+	D;JEQ
+	@R13
+	M=0
+(EVAL_74)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f5 2
+(Board.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 37
+	@37
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_5
+(LOOP_BOARD_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Board.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_75
+	D;JGT
+	@R13
+	M=0
+(EVAL_75)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_76
+	D;JLT
+	@R13
+	M=0
+(EVAL_76)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f6 1
+	@Board.f6$ret24
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f6
+	0;JMP
+(Board.f6$ret24)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_5
+	@DONE_BOARD_5
+	0;JMP
+// label SMALL_BOARD_5
+(SMALL_BOARD_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_5
+(DONE_BOARD_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_77
+	D;JEQ
+	@R13
+	M=0
+(EVAL_77)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f6 2
+(Board.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 44
+	@44
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_6
+(LOOP_BOARD_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Board.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_78
+	D;JGT
+	@R13
+	M=0
+(EVAL_78)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_79
+	D;JLT
+	@R13
+	M=0
+(EVAL_79)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f7 1
+	@Board.f7$ret25
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f7
+	0;JMP
+(Board.f7$ret25)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_6
+	@DONE_BOARD_6
+	0;JMP
+// label SMALL_BOARD_6
+(SMALL_BOARD_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_6
+(DONE_BOARD_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_80
+	D;JEQ
+	@R13
+	M=0
+(EVAL_80)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f7 2
+(Board.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 51
+	@51
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_7
+(LOOP_BOARD_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Board.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_81
+	D;JGT
+	@R13
+	M=0
+(EVAL_81)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_82
+	D;JLT
+	@R13
+	M=0
+(EVAL_82)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f8 1
+	@Board.f8$ret26
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f8
+	0;JMP
+(Board.f8$ret26)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_7
+	@DONE_BOARD_7
+	0;JMP
+// label SMALL_BOARD_7
+(SMALL_BOARD_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_7
+(DONE_BOARD_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_83
+	D;JEQ
+	@R13
+	M=0
+(EVAL_83)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f8 2
+(Board.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 58
+	@58
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_8
+(LOOP_BOARD_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Board.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_84
+	D;JGT
+	@R13
+	M=0
+(EVAL_84)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_85
+	D;JLT
+	@R13
+	M=0
+(EVAL_85)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f9 1
+	@Board.f9$ret27
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f9
+	0;JMP
+(Board.f9$ret27)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_BOARD_8
+	@DONE_BOARD_8
+	0;JMP
+// label SMALL_BOARD_8
+(SMALL_BOARD_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_8
+(DONE_BOARD_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_86
+	D;JEQ
+	@R13
+	M=0
+(EVAL_86)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Board.f9 2
+(Board.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 65
+	@65
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_BOARD_9
+(LOOP_BOARD_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Board.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Board.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_87
+	D;JGT
+	@R13
+	M=0
+(EVAL_87)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_BOARD_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_BOARD_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_88
+	D;JLT
+	@R13
+	M=0
+(EVAL_88)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_BOARD_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_BOARD_9
+	D;JNE
+// goto DONE_BOARD_9
+	@DONE_BOARD_9
+	0;JMP
+// label SMALL_BOARD_9
+(SMALL_BOARD_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_BOARD_9
+(DONE_BOARD_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_89
+	D;JEQ
+	@R13
+	M=0
+(EVAL_89)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f0 2
+(Game.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_0
+(LOOP_GAME_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Game.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_90
+	D;JGT
+	@R13
+	M=0
+(EVAL_90)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_91
+	D;JLT
+	@R13
+	M=0
+(EVAL_91)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f1 1
+	@Game.f1$ret28
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f1
+	0;JMP
+(Game.f1$ret28)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_0
+	@DONE_GAME_0
+	0;JMP
+// label SMALL_GAME_0
+(SMALL_GAME_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_0
+(DONE_GAME_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_92
+	D;JEQ
+	@R13
+	M=0
+(EVAL_92)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f1 2
+(Game.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 10
+	@10
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_1
+(LOOP_GAME_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Game.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_93
+	D;JGT
+	@R13
+	M=0
+(EVAL_93)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_94
+	D;JLT
+	@R13
+	M=0
+(EVAL_94)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f2 1
+	@Game.f2$ret29
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f2
+	0;JMP
+(Game.f2$ret29)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_1
+	@DONE_GAME_1
+	0;JMP
+// label SMALL_GAME_1
+(SMALL_GAME_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_1
+(DONE_GAME_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_95
+	D;JEQ
+	@R13
+	M=0
+(EVAL_95)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f2 2
+(Game.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 17
+	@17
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_2
+(LOOP_GAME_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Game.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_96
+	D;JGT
+	@R13
+	M=0
+(EVAL_96)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_97
+	D;JLT
+	@R13
+	M=0
+(EVAL_97)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f3 1
+	@Game.f3$ret30
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f3
+	0;JMP
+(Game.f3$ret30)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_2
+	@DONE_GAME_2
+	0;JMP
+// label SMALL_GAME_2
+(SMALL_GAME_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_2
+(DONE_GAME_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_98
+	D;JEQ
+	@R13
+	M=0
+(EVAL_98)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f3 2
+(Game.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 24
+	@24
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_3
+(LOOP_GAME_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Game.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_99
+	D;JGT
+	@R13
+	M=0
+(EVAL_99)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_100
+	D;JLT
+	@R13
+	M=0
+(EVAL_100)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f4 1
+	@Game.f4$ret31
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f4
+	0;JMP
+(Game.f4$ret31)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_3
+	@DONE_GAME_3
+	0;JMP
+// label SMALL_GAME_3
+(SMALL_GAME_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_3
+(DONE_GAME_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_101
+	D;JEQ
+	@R13
+	M=0
+(EVAL_101)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f4 2
+(Game.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 31
+	@31
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_4
+(LOOP_GAME_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Game.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_102
+	D;JGT
+	@R13
+	M=0
+(EVAL_102)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_103
+	D;JLT
+	@R13
+	M=0
+(EVAL_103)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f5 1
+	@Game.f5$ret32
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f5
+	0;JMP
+(Game.f5$ret32)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_4
+	@DONE_GAME_4
+	0;JMP
+// label SMALL_GAME_4
+(SMALL_GAME_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_4
+(DONE_GAME_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_104
+	D;JEQ
+	@R13
+	M=0
+(EVAL_104)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f5 2
+(Game.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 38
+	@38
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_5
+(LOOP_GAME_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Game.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_105
+	D;JGT
+	@R13
+	M=0
+(EVAL_105)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_106
+	D;JLT
+	@R13
+	M=0
+(EVAL_106)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f6 1
+	@Game.f6$ret33
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f6
+	0;JMP
+(Game.f6$ret33)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_5
+	@DONE_GAME_5
+	0;JMP
+// label SMALL_GAME_5
+(SMALL_GAME_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_5
+(DONE_GAME_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_107
+	D;JEQ
+	@R13
+	M=0
+(EVAL_107)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f6 2
+(Game.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 45
+	@45
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_6
+(LOOP_GAME_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Game.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_108
+	D;JGT
+	@R13
+	M=0
+(EVAL_108)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_109
+	D;JLT
+	@R13
+	M=0
+(EVAL_109)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f7 1
+	@Game.f7$ret34
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f7
+	0;JMP
+(Game.f7$ret34)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_6
+	@DONE_GAME_6
+	0;JMP
+// label SMALL_GAME_6
+(SMALL_GAME_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_6
+(DONE_GAME_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_110
+	D;JEQ
+	@R13
+	M=0
+(EVAL_110)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f7 2
+(Game.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 52
+	@52
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_7
+(LOOP_GAME_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Game.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_111
+	D;JGT
+	@R13
+	M=0
+(EVAL_111)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_112
+	D;JLT
+	@R13
+	M=0
+(EVAL_112)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f8 1
+	@Game.f8$ret35
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f8
+	0;JMP
+(Game.f8$ret35)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_7
+	@DONE_GAME_7
+	0;JMP
+// label SMALL_GAME_7
+(SMALL_GAME_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_7
+(DONE_GAME_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_113
+	D;JEQ
+	@R13
+	M=0
+(EVAL_113)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f8 2
+(Game.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 59
+	@59
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_8
+(LOOP_GAME_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Game.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_114
+	D;JGT
+	@R13
+	M=0
+(EVAL_114)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_115
+	D;JLT
+	@R13
+	M=0
+(EVAL_115)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f9 1
+	@Game.f9$ret36
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f9
+	0;JMP
+(Game.f9$ret36)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_GAME_8
+	@DONE_GAME_8
+	0;JMP
+// label SMALL_GAME_8
+(SMALL_GAME_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_8
+(DONE_GAME_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_116
+	D;JEQ
+	@R13
+	M=0
+(EVAL_116)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Game.f9 2
+(Game.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 66
+	@66
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_GAME_9
+(LOOP_GAME_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Game.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Game.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_117
+	D;JGT
+	@R13
+	M=0
+(EVAL_117)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_GAME_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_GAME_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_118
+	D;JLT
+	@R13
+	M=0
+(EVAL_118)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_GAME_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_GAME_9
+	D;JNE
+// goto DONE_GAME_9
+	@DONE_GAME_9
+	0;JMP
+// label SMALL_GAME_9
+(SMALL_GAME_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_GAME_9
+(DONE_GAME_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_119
+	D;JEQ
+	@R13
+	M=0
+(EVAL_119)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Main.main 1
+(Main.main)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Ball.f0 1
+	@Ball.f0$ret37
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Ball.f0
+	0;JMP
+(Ball.f0$ret37)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Bat.f0 1
+	@Bat.f0$ret38
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Bat.f0
+	0;JMP
+(Bat.f0$ret38)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Board.f0 1
+	@Board.f0$ret39
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Board.f0
+	0;JMP
+(Board.f0$ret39)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Game.f0 1
+	@Game.f0$ret40
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Game.f0
+	0;JMP
+(Game.f0$ret40)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f0 1
+	@Score.f0$ret41
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f0
+	0;JMP
+(Score.f0$ret41)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f0 1
+	@Screen.f0$ret42
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f0
+	0;JMP
+(Screen.f0$ret42)
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f0 2
+(Score.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_0
+(LOOP_SCORE_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Score.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_120
+	D;JGT
+	@R13
+	M=0
+(EVAL_120)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_121
+	D;JLT
+	@R13
+	M=0
+(EVAL_121)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f1 1
+	@Score.f1$ret43
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f1
+	0;JMP
+(Score.f1$ret43)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_0
+	@DONE_SCORE_0
+	0;JMP
+// label SMALL_SCORE_0
+(SMALL_SCORE_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_0
+(DONE_SCORE_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_122
+	D;JEQ
+	@R13
+	M=0
+(EVAL_122)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f1 2
+(Score.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 11
+	@11
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_1
+(LOOP_SCORE_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Score.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_123
+	D;JGT
+	@R13
+	M=0
+(EVAL_123)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_124
+	D;JLT
+	@R13
+	M=0
+(EVAL_124)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f2 1
+	@Score.f2$ret44
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f2
+	0;JMP
+(Score.f2$ret44)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_1
+	@DONE_SCORE_1
+	0;JMP
+// label SMALL_SCORE_1
+(SMALL_SCORE_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_1
+(DONE_SCORE_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_125
+	D;JEQ
+	@R13
+	M=0
+(EVAL_125)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f2 2
+(Score.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 18
+	@18
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_2
+(LOOP_SCORE_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Score.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_126
+	D;JGT
+	@R13
+	M=0
+(EVAL_126)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_127
+	D;JLT
+	@R13
+	M=0
+(EVAL_127)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f3 1
+	@Score.f3$ret45
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f3
+	0;JMP
+(Score.f3$ret45)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_2
+	@DONE_SCORE_2
+	0;JMP
+// label SMALL_SCORE_2
+(SMALL_SCORE_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_2
+(DONE_SCORE_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_128
+	D;JEQ
+	@R13
+	M=0
+(EVAL_128)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f3 2
+(Score.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 25
+	@25
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_3
+(LOOP_SCORE_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Score.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_129
+	D;JGT
+	@R13
+	M=0
+(EVAL_129)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_130
+	D;JLT
+	@R13
+	M=0
+(EVAL_130)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f4 1
+	@Score.f4$ret46
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f4
+	0;JMP
+(Score.f4$ret46)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_3
+	@DONE_SCORE_3
+	0;JMP
+// label SMALL_SCORE_3
+(SMALL_SCORE_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_3
+(DONE_SCORE_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_131
+	D;JEQ
+	@R13
+	M=0
+(EVAL_131)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f4 2
+(Score.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 32
+	@32
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_4
+(LOOP_SCORE_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Score.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_132
+	D;JGT
+	@R13
+	M=0
+(EVAL_132)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_133
+	D;JLT
+	@R13
+	M=0
+(EVAL_133)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f5 1
+	@Score.f5$ret47
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f5
+	0;JMP
+(Score.f5$ret47)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_4
+	@DONE_SCORE_4
+	0;JMP
+// label SMALL_SCORE_4
+(SMALL_SCORE_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_4
+(DONE_SCORE_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_134
+	D;JEQ
+	@R13
+	M=0
+(EVAL_134)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f5 2
+(Score.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 39
+	@39
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_5
+(LOOP_SCORE_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Score.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_135
+	D;JGT
+	@R13
+	M=0
+(EVAL_135)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_136
+	D;JLT
+	@R13
+	M=0
+(EVAL_136)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f6 1
+	@Score.f6$ret48
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f6
+	0;JMP
+(Score.f6$ret48)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_5
+	@DONE_SCORE_5
+	0;JMP
+// label SMALL_SCORE_5
+(SMALL_SCORE_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_5
+(DONE_SCORE_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_137
+	D;JEQ
+	@R13
+	M=0
+(EVAL_137)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f6 2
+(Score.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 46
+	@46
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_6
+(LOOP_SCORE_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Score.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_138
+	D;JGT
+	@R13
+	M=0
+(EVAL_138)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_139
+	D;JLT
+	@R13
+	M=0
+(EVAL_139)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f7 1
+	@Score.f7$ret49
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f7
+	0;JMP
+(Score.f7$ret49)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_6
+	@DONE_SCORE_6
+	0;JMP
+// label SMALL_SCORE_6
+(SMALL_SCORE_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_6
+(DONE_SCORE_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_140
+	D;JEQ
+	@R13
+	M=0
+(EVAL_140)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f7 2
+(Score.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 53
+	@53
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_7
+(LOOP_SCORE_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Score.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_141
+	D;JGT
+	@R13
+	M=0
+(EVAL_141)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_142
+	D;JLT
+	@R13
+	M=0
+(EVAL_142)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f8 1
+	@Score.f8$ret50
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f8
+	0;JMP
+(Score.f8$ret50)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_7
+	@DONE_SCORE_7
+	0;JMP
+// label SMALL_SCORE_7
+(SMALL_SCORE_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_7
+(DONE_SCORE_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_143
+	D;JEQ
+	@R13
+	M=0
+(EVAL_143)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f8 2
+(Score.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 60
+	@60
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_8
+(LOOP_SCORE_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Score.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_144
+	D;JGT
+	@R13
+	M=0
+(EVAL_144)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_145
+	D;JLT
+	@R13
+	M=0
+(EVAL_145)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Score.f9 1
+	@Score.f9$ret51
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Score.f9
+	0;JMP
+(Score.f9$ret51)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCORE_8
+	@DONE_SCORE_8
+	0;JMP
+// label SMALL_SCORE_8
+(SMALL_SCORE_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_8
+(DONE_SCORE_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_146
+	D;JEQ
+	@R13
+	M=0
+(EVAL_146)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Score.f9 2
+(Score.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 67
+	@67
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCORE_9
+(LOOP_SCORE_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Score.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Score.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_147
+	D;JGT
+	@R13
+	M=0
+(EVAL_147)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCORE_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCORE_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_148
+	D;JLT
+	@R13
+	M=0
+(EVAL_148)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCORE_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCORE_9
+	D;JNE
+// goto DONE_SCORE_9
+	@DONE_SCORE_9
+	0;JMP
+// label SMALL_SCORE_9
+(SMALL_SCORE_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCORE_9
+(DONE_SCORE_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_149
+	D;JEQ
+	@R13
+	M=0
+(EVAL_149)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f0 2
+(Screen.f0)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_0
+(LOOP_SCREEN_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Screen.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_150
+	D;JGT
+	@R13
+	M=0
+(EVAL_150)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_151
+	D;JLT
+	@R13
+	M=0
+(EVAL_151)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_0
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f1 1
+	@Screen.f1$ret52
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f1
+	0;JMP
+(Screen.f1$ret52)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_0
+	@DONE_SCREEN_0
+	0;JMP
+// label SMALL_SCREEN_0
+(SMALL_SCREEN_0)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_0
+(DONE_SCREEN_0)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_152
+	D;JEQ
+	@R13
+	M=0
+(EVAL_152)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f1 2
+(Screen.f1)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 12
+	@12
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_1
+(LOOP_SCREEN_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Screen.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_153
+	D;JGT
+	@R13
+	M=0
+(EVAL_153)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_154
+	D;JLT
+	@R13
+	M=0
+(EVAL_154)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_1
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f2 1
+	@Screen.f2$ret53
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f2
+	0;JMP
+(Screen.f2$ret53)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_1
+	@DONE_SCREEN_1
+	0;JMP
+// label SMALL_SCREEN_1
+(SMALL_SCREEN_1)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_1
+(DONE_SCREEN_1)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_155
+	D;JEQ
+	@R13
+	M=0
+(EVAL_155)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f2 2
+(Screen.f2)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 19
+	@19
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_2
+(LOOP_SCREEN_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Screen.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_156
+	D;JGT
+	@R13
+	M=0
+(EVAL_156)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_157
+	D;JLT
+	@R13
+	M=0
+(EVAL_157)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_2
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f3 1
+	@Screen.f3$ret54
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f3
+	0;JMP
+(Screen.f3$ret54)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_2
+	@DONE_SCREEN_2
+	0;JMP
+// label SMALL_SCREEN_2
+(SMALL_SCREEN_2)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_2
+(DONE_SCREEN_2)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_158
+	D;JEQ
+	@R13
+	M=0
+(EVAL_158)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f3 2
+(Screen.f3)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 26
+	@26
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_3
+(LOOP_SCREEN_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Screen.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_159
+	D;JGT
+	@R13
+	M=0
+(EVAL_159)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_160
+	D;JLT
+	@R13
+	M=0
+(EVAL_160)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_3
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f4 1
+	@Screen.f4$ret55
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f4
+	0;JMP
+(Screen.f4$ret55)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_3
+	@DONE_SCREEN_3
+	0;JMP
+// label SMALL_SCREEN_3
+(SMALL_SCREEN_3)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_3
+(DONE_SCREEN_3)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_161
+	D;JEQ
+	@R13
+	M=0
+(EVAL_161)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f4 2
+(Screen.f4)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 33
+	@33
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_4
+(LOOP_SCREEN_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Screen.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_162
+	D;JGT
+	@R13
+	M=0
+(EVAL_162)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_163
+	D;JLT
+	@R13
+	M=0
+(EVAL_163)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_4
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_4
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f5 1
+	@Screen.f5$ret56
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f5
+	0;JMP
+(Screen.f5$ret56)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_4
+	@DONE_SCREEN_4
+	0;JMP
+// label SMALL_SCREEN_4
+(SMALL_SCREEN_4)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_4
+(DONE_SCREEN_4)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_164
+	D;JEQ
+	@R13
+	M=0
+(EVAL_164)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f5 2
+(Screen.f5)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 40
+	@40
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_5
+(LOOP_SCREEN_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Screen.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_165
+	D;JGT
+	@R13
+	M=0
+(EVAL_165)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_166
+	D;JLT
+	@R13
+	M=0
+(EVAL_166)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_5
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_5
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f6 1
+	@Screen.f6$ret57
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f6
+	0;JMP
+(Screen.f6$ret57)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_5
+	@DONE_SCREEN_5
+	0;JMP
+// label SMALL_SCREEN_5
+(SMALL_SCREEN_5)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_5
+(DONE_SCREEN_5)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_167
+	D;JEQ
+	@R13
+	M=0
+(EVAL_167)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f6 2
+(Screen.f6)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 47
+	@47
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_6
+(LOOP_SCREEN_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 2
+	@Screen.2
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 2
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.2
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_168
+	D;JGT
+	@R13
+	M=0
+(EVAL_168)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_169
+	D;JLT
+	@R13
+	M=0
+(EVAL_169)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_6
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_6
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f7 1
+	@Screen.f7$ret58
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f7
+	0;JMP
+(Screen.f7$ret58)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_6
+	@DONE_SCREEN_6
+	0;JMP
+// label SMALL_SCREEN_6
+(SMALL_SCREEN_6)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_6
+(DONE_SCREEN_6)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_170
+	D;JEQ
+	@R13
+	M=0
+(EVAL_170)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f7 2
+(Screen.f7)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 54
+	@54
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_7
+(LOOP_SCREEN_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 3
+	@Screen.3
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 3
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.3
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_171
+	D;JGT
+	@R13
+	M=0
+(EVAL_171)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_172
+	D;JLT
+	@R13
+	M=0
+(EVAL_172)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_7
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_7
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f8 1
+	@Screen.f8$ret59
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f8
+	0;JMP
+(Screen.f8$ret59)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_7
+	@DONE_SCREEN_7
+	0;JMP
+// label SMALL_SCREEN_7
+(SMALL_SCREEN_7)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_7
+(DONE_SCREEN_7)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_173
+	D;JEQ
+	@R13
+	M=0
+(EVAL_173)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f8 2
+(Screen.f8)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 61
+	@61
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 4
+	@4
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_8
+(LOOP_SCREEN_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 0
+	@Screen.0
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.0
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_174
+	D;JGT
+	@R13
+	M=0
+(EVAL_174)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_175
+	D;JLT
+	@R13
+	M=0
+(EVAL_175)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_8
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_8
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// call Screen.f9 1
+	@Screen.f9$ret60
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@6
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Screen.f9
+	0;JMP
+(Screen.f9$ret60)
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// goto DONE_SCREEN_8
+	@DONE_SCREEN_8
+	0;JMP
+// label SMALL_SCREEN_8
+(SMALL_SCREEN_8)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_8
+(DONE_SCREEN_8)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_176
+	D;JEQ
+	@R13
+	M=0
+(EVAL_176)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Screen.f9 2
+(Screen.f9)
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push argument 0
+	@0
+	D=A
+	@ARG
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 68
+	@68
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// add
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D+M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 0
+	@0
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push constant 5
+	@5
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// label LOOP_SCREEN_9
+(LOOP_SCREEN_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push static 1
+	@Screen.1
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 3
+	@3
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// and
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D&M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop static 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@Screen.1
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 1
+	@1
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// sub
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop local 1
+	@1
+	D=A
+	@LCL
+	D=D+M
+	@R13
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@R13
+	A=M
+	M=D
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// gt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_177
+	D;JGT
+	@R13
+	M=0
+(EVAL_177)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto LOOP_SCREEN_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@LOOP_SCREEN_9
+	D;JNE
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push constant 2
+	@2
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// lt
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_178
+	D;JLT
+	@R13
+	M=0
+(EVAL_178)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// if-goto SMALL_SCREEN_9
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SMALL_SCREEN_9
+	D;JNE
+// goto DONE_SCREEN_9
+	@DONE_SCREEN_9
+	0;JMP
+// label SMALL_SCREEN_9
+(SMALL_SCREEN_9)
+// push constant 0
+	@0
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// pop temp 1
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@6
+	M=D
+// label DONE_SCREEN_9
+(DONE_SCREEN_9)
+// push local 0
+	@0
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// neg
+	@0
+	D=A
+	@SP
+	M=M-1
+	A=M
+	D=D-M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// not
+	@SP
+	M=M-1
+	A=M
+	D=M
+	D=!D
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push temp 1
+	@6
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// eq
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=M-D
+	@R13
+	M=-1
+	@EVAL_179
+	D;JEQ
+	@R13
+	M=0
+(EVAL_179)
+	@R13
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// push local 1
+	@1
+	D=A
+	@LCL
+	A=D+M
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// or
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@SP
+	M=M-1
+	A=M
+	D=D|M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+// return
+	@LCL
+	D=A
+	D=M
+	@frame
+	M=D
+	@5
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@retAddr
+	M=D
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@ARG
+	A=M
+	M=D
+	@ARG
+	D=M+1
+	@SP
+	M=D
+	@1
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THAT
+	M=D
+	@2
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@THIS
+	M=D
+	@3
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@ARG
+	M=D
+	@4
+	D=A
+	@frame
+	D=M-D
+	A=D
+	D=M
+	@LCL
+	M=D
+	@retAddr
+	A=M
+	0;JMP
+// function Sys.init 0
+(Sys.init)
+// call Main.main 0
+	@Main.main$ret61
+	D=A
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@LCL
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@ARG
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THIS
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@THAT
+	D=M
+	@SP
+	A=M
+	M=D
+	@SP
+	M=M+1
+	@5
+	D=A
+	@SP
+	D=M-D
+	@ARG
+	M=D
+	@SP
+	D=M
+	@LCL
+	M=D
+	@Main.main
+	0;JMP
+(Main.main$ret61)
+// pop temp 0
+	@SP
+	M=M-1
+	A=M
+	D=M
+	@5
+	M=D
+// label HALT
+(HALT)
+// goto HALT
+	@HALT
+	0;JMP
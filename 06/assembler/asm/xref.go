@@ -0,0 +1,132 @@
+package asm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Access tells what the instruction after an A-command does with the address it loads
+type Access int
+
+const (
+	// Jump means the next C-instruction jumps to A
+	Jump Access = 1 << iota
+	// Read means the next C-instruction reads M
+	Read
+	// Write means the next C-instruction writes M
+	Write
+)
+
+// String names the access, or "value" when A is only used as a number (D=A, say)
+func (a Access) String() string {
+	if a == 0 {
+		return "value"
+	}
+	parts := []string{}
+	if a&Jump != 0 {
+		parts = append(parts, "jump")
+	}
+	if a&Read != 0 {
+		parts = append(parts, "read")
+	}
+	if a&Write != 0 {
+		parts = append(parts, "write")
+	}
+	return strings.Join(parts, "+")
+}
+
+// Use is one place a symbol is loaded with @symbol
+type Use struct {
+	Line   int
+	Col    int
+	Addr   int    // ROM address of the A-command
+	Access Access // judged by the following C-instruction
+	Next   string // the following C-instruction, if there is one
+}
+
+// XRef lists where a symbol is defined and used
+type XRef struct {
+	Symbol *Symbol
+	Uses   []Use
+}
+
+// CrossReference returns the uses of each label and variable, and of the predefined symbols
+// the program uses, in the order of the symbol file
+func (p *Program) CrossReference() []XRef {
+	uses := map[string][]Use{}
+	for i := range p.Commands {
+		c := &p.Commands[i]
+		if c.Type != ACommand || charClass[c.Symbol[0]] == classDigit {
+			continue
+		}
+		u := Use{Line: c.Line, Col: c.SymCol, Addr: c.Addr}
+		// labels do not change A, so look past them for the instruction that uses it
+		for _, next := range p.Commands[i+1:] {
+			if next.Type == LCommand {
+				continue
+			}
+			if next.Type == CCommand {
+				u.Access = access(&next)
+				u.Next = next.Text
+			}
+			break
+		}
+		uses[c.Symbol] = append(uses[c.Symbol], u)
+	}
+
+	syms := []*Symbol{}
+	for _, sym := range p.Symbols.Symbols() {
+		if sym.Kind != Predefined || len(uses[sym.Name]) > 0 {
+			syms = append(syms, sym)
+		}
+	}
+	sortByAddress(syms)
+	refs := make([]XRef, len(syms))
+	for i, sym := range syms {
+		refs[i] = XRef{Symbol: sym, Uses: uses[sym.Name]}
+	}
+	return refs
+}
+
+// access works out what a C-instruction does with A
+func access(c *Command) Access {
+	var a Access
+	if c.Jump != "" {
+		a |= Jump
+	}
+	if strings.IndexByte(c.Comp, 'M') >= 0 {
+		a |= Read
+	}
+	if strings.IndexByte(c.Dest, 'M') >= 0 {
+		a |= Write
+	}
+	return a
+}
+
+// WriteXRef writes the cross-reference report: each symbol with its definition, then one
+// line per use with its source line, ROM address, access and the instruction that follows
+func (p *Program) WriteXRef(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, ref := range p.CrossReference() {
+		sym := ref.Symbol
+		switch sym.Kind {
+		case Label:
+			fmt.Fprintf(bw, "%s label ROM[%d], defined on line %d", sym.Name, sym.Address, sym.Line)
+		case Variable:
+			fmt.Fprintf(bw, "%s variable RAM[%d], first used on line %d", sym.Name, sym.Address, sym.Line)
+		default:
+			fmt.Fprintf(bw, "%s predefined %d", sym.Name, sym.Address)
+		}
+		fmt.Fprintf(bw, ", %d use(s)\n", len(ref.Uses))
+		for _, u := range ref.Uses {
+			fmt.Fprintf(bw, "  line %d ROM[%d] %s", u.Line, u.Addr, u.Access)
+			if u.Next != "" {
+				fmt.Fprintf(bw, " %s", u.Next)
+			}
+			fmt.Fprintln(bw)
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,290 @@
+// hackdiff compares two .hack files word by word. Every mismatch is shown with its ROM address,
+// the nearest label (from an optional .sym file written by the assembler's --sym flag) and both
+// words decoded, followed by summary statistics.
+//
+// Exit status is 0 if the files match, 1 if they differ and 2 on trouble, like cmp.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"hack-assembler/asm"
+)
+
+func main() {
+	symFile := flag.String("sym", "", "symbol file for naming ROM addresses")
+	profile := flag.String("isa", "", "ISA profile for decoding extended instructions")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: hackdiff [-sym file.sym] [-isa profile] old.hack new.hack")
+		os.Exit(2)
+	}
+
+	isa := asm.Standard()
+	if *profile != "" {
+		var err error
+		if isa, err = asm.LoadISAFile(*profile); err != nil {
+			fail(err)
+		}
+	}
+	labels := []*asm.Symbol{}
+	if *symFile != "" {
+		var err error
+		if labels, err = readLabels(*symFile); err != nil {
+			fail(err)
+		}
+	}
+	oldCode, err := readHack(flag.Arg(0))
+	if err != nil {
+		fail(err)
+	}
+	newCode, err := readHack(flag.Arg(1))
+	if err != nil {
+		fail(err)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	s := diff(out, isa, labels, oldCode, newCode)
+	s.print(out, flag.Arg(0), flag.Arg(1))
+	if s.differ() {
+		out.Flush()
+		os.Exit(1)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "hackdiff:", err)
+	os.Exit(2)
+}
+
+// readHack reads the words of a .hack file
+func readHack(name string) ([]uint16, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	code := []uint16{}
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		word, err := asm.ParseWord(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+		code = append(code, word)
+	}
+	return code, scanner.Err()
+}
+
+// readLabels reads the labels of a symbol file, sorted by address
+func readLabels(name string) ([]*asm.Symbol, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	syms, err := asm.ReadSymbols(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	labels := []*asm.Symbol{}
+	for _, sym := range syms {
+		if sym.Kind == asm.Label {
+			labels = append(labels, sym)
+		}
+	}
+	sort.SliceStable(labels, func(i, j int) bool { return labels[i].Address < labels[j].Address })
+	return labels, nil
+}
+
+// nearestLabel names addr relative to the closest label at or before it, as LOOP or LOOP+3
+func nearestLabel(labels []*asm.Symbol, addr int) string {
+	i := sort.Search(len(labels), func(i int) bool { return labels[i].Address > addr })
+	if i == 0 {
+		return ""
+	}
+	label := labels[i-1]
+	// several labels can share an address; use the one listed first, which in a .sym file is
+	// the first by name
+	for i > 1 && labels[i-2].Address == label.Address {
+		i--
+		label = labels[i-1]
+	}
+	if label.Address == addr {
+		return label.Name
+	}
+	return fmt.Sprintf("%s+%d", label.Name, addr-label.Address)
+}
+
+// stats counts the kinds of mismatch, to help triage a regression
+type stats struct {
+	compared int // words present in both files
+	oldLen   int
+	newLen   int
+	aValue   int // both A-instructions, with different values
+	cInstr   int // both C-instructions, with different bits
+	cComp    int // C-instruction mismatches by field; one word can count in several
+	cDest    int
+	cJump    int
+	cOther   int // C-instructions that differ outside comp/dest/jump (prefix bits)
+	kind     int // an A-instruction in one file and a C-instruction in the other
+	extra    int // words present in only one file
+	runs     int // stretches of consecutive mismatches
+	first    int // ROM address of the first mismatch, or -1
+	deltas   map[int]int
+}
+
+func (s *stats) mismatches() int {
+	return s.aValue + s.cInstr + s.kind + s.extra
+}
+
+// diff writes one line per mismatched word and returns the statistics
+func diff(out *bufio.Writer, isa *asm.ISA, labels []*asm.Symbol, oldCode, newCode []uint16) *stats {
+	s := &stats{oldLen: len(oldCode), newLen: len(newCode), first: -1, deltas: map[int]int{}}
+	n := len(oldCode)
+	if len(newCode) > n {
+		n = len(newCode)
+	}
+	prevDiffered := false
+	for addr := 0; addr < n; addr++ {
+		var oldWord, newWord *uint16
+		if addr < len(oldCode) {
+			oldWord = &oldCode[addr]
+		}
+		if addr < len(newCode) {
+			newWord = &newCode[addr]
+		}
+		if oldWord != nil && newWord != nil {
+			s.compared++
+			if *oldWord == *newWord {
+				prevDiffered = false
+				continue
+			}
+			s.count(*oldWord, *newWord)
+		} else {
+			s.extra++
+		}
+		if !prevDiffered {
+			s.runs++
+		}
+		prevDiffered = true
+		if s.first < 0 {
+			s.first = addr
+		}
+		fmt.Fprintf(out, "ROM[%d]", addr)
+		if label := nearestLabel(labels, addr); label != "" {
+			fmt.Fprintf(out, " %s", label)
+		}
+		fmt.Fprintf(out, ": %s | %s\n", describe(isa, oldWord), strings.TrimRight(describe(isa, newWord), " "))
+	}
+	return s
+}
+
+// count classifies a mismatch between two words at the same address
+func (s *stats) count(oldWord, newWord uint16) {
+	oldA, newA := oldWord&0x8000 == 0, newWord&0x8000 == 0
+	switch {
+	case oldA && newA:
+		s.aValue++
+		s.deltas[int(newWord)-int(oldWord)]++
+	case oldA != newA:
+		s.kind++
+	default:
+		s.cInstr++
+		if (oldWord^newWord)>>6&0x7f != 0 {
+			s.cComp++
+		}
+		if (oldWord^newWord)>>3&0x7 != 0 {
+			s.cDest++
+		}
+		if (oldWord^newWord)&0x7 != 0 {
+			s.cJump++
+		}
+		if (oldWord^newWord)>>13 != 0 {
+			s.cOther++
+		}
+	}
+}
+
+// describe shows a word in binary with its disassembly
+func describe(isa *asm.ISA, word *uint16) string {
+	if word == nil {
+		return fmt.Sprintf("%-16s %-12s", "(missing)", "")
+	}
+	instr, err := isa.Disassemble(*word)
+	if err != nil {
+		instr = "(invalid)"
+	}
+	return fmt.Sprintf("%s %-12s", asm.FormatWord(*word), instr)
+}
+
+func (s *stats) differ() bool {
+	return s.mismatches() > 0
+}
+
+// print writes the summary
+func (s *stats) print(out *bufio.Writer, oldName, newName string) {
+	if !s.differ() {
+		fmt.Fprintf(out, "%s and %s are identical (%d words)\n", oldName, newName, s.compared)
+		return
+	}
+	fmt.Fprintln(out)
+	// out of the words in the longer file, so the extra words of the other count as mismatches
+	words := s.oldLen
+	if s.newLen > words {
+		words = s.newLen
+	}
+	pct := 100 * float64(s.mismatches()) / float64(words)
+	fmt.Fprintf(out, "%d of %d words differ (%.1f%%) in %d run(s), first at ROM[%d]\n", s.mismatches(), words, pct, s.runs, s.first)
+	fmt.Fprintf(out, "  A-instruction values: %d\n", s.aValue)
+	if len(s.deltas) > 0 {
+		fmt.Fprintf(out, "    value changes: %s\n", s.topDeltas(5))
+	}
+	fmt.Fprintf(out, "  C-instructions:       %d (comp %d, dest %d, jump %d, prefix %d)\n", s.cInstr, s.cComp, s.cDest, s.cJump, s.cOther)
+	fmt.Fprintf(out, "  A/C kind changes:     %d\n", s.kind)
+	switch {
+	case s.oldLen > s.newLen:
+		fmt.Fprintf(out, "  %s has %d extra words (%d vs %d)\n", oldName, s.oldLen-s.newLen, s.oldLen, s.newLen)
+	case s.newLen > s.oldLen:
+		fmt.Fprintf(out, "  %s has %d extra words (%d vs %d)\n", newName, s.newLen-s.oldLen, s.newLen, s.oldLen)
+	}
+}
+
+// topDeltas lists the most common A-instruction value changes; a single shift usually means
+// a label or variable moved, and everything after it followed
+func (s *stats) topDeltas(n int) string {
+	type delta struct{ by, count int }
+	ds := []delta{}
+	for by, count := range s.deltas {
+		ds = append(ds, delta{by, count})
+	}
+	sort.Slice(ds, func(i, j int) bool {
+		if ds[i].count != ds[j].count {
+			return ds[i].count > ds[j].count
+		}
+		return ds[i].by < ds[j].by
+	})
+	parts := []string{}
+	for i, d := range ds {
+		if i == n {
+			parts = append(parts, "...")
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%+d ×%d", d.by, d.count))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"hack-assembler/asm"
+)
+
+func TestNearestLabel(t *testing.T) {
+	labels := []*asm.Symbol{
+		{Name: "START", Address: 2, Kind: asm.Label},
+		{Name: "LOOP", Address: 5, Kind: asm.Label},
+		{Name: "ALIAS", Address: 5, Kind: asm.Label},
+		{Name: "END", Address: 9, Kind: asm.Label},
+	}
+	tests := []struct {
+		addr int
+		want string
+	}{
+		{0, ""},
+		{2, "START"},
+		{4, "START+2"},
+		{5, "LOOP"},
+		{7, "LOOP+2"},
+		{9, "END"},
+		{20, "END+11"},
+	}
+	for _, tt := range tests {
+		if got := nearestLabel(labels, tt.addr); got != tt.want {
+			t.Errorf("nearestLabel(%d) = %q, want %q", tt.addr, got, tt.want)
+		}
+	}
+	if got := nearestLabel(nil, 3); got != "" {
+		t.Errorf("nearestLabel with no labels = %q, want none", got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	const (
+		a1    = 1
+		a2    = 2
+		dEqM  = 0b1111110000010000 // D=M
+		dEqA  = 0b1110110000010000 // D=A
+		jmp   = 0b1110101010000111 // 0;JMP
+		mEqD1 = 0b1110011111001000 // M=D+1
+	)
+	labels := []*asm.Symbol{{Name: "LOOP", Address: 2, Kind: asm.Label}}
+	tests := []struct {
+		name     string
+		old, new []uint16
+		lines    []string // the start of each mismatch line
+		summary  string   // the first line of the summary
+		differ   bool
+		extra    int
+	}{
+		{
+			name:    "equal",
+			old:     []uint16{a1, dEqM, jmp},
+			new:     []uint16{a1, dEqM, jmp},
+			summary: "old.hack and new.hack are identical (3 words)",
+		},
+		{
+			name:    "differing words",
+			old:     []uint16{a1, dEqM, a1, jmp},
+			new:     []uint16{a2, dEqA, a1, mEqD1},
+			lines:   []string{"ROM[0]: ", "ROM[1]: ", "ROM[3] LOOP+1: "},
+			summary: "3 of 4 words differ (75.0%) in 2 run(s), first at ROM[0]",
+			differ:  true,
+		},
+		{
+			name:    "new file longer",
+			old:     []uint16{a1, dEqM},
+			new:     []uint16{a1, dEqM, a2, jmp},
+			lines:   []string{"ROM[2] LOOP: (missing)", "ROM[3] LOOP+1: "},
+			summary: "2 of 4 words differ (50.0%) in 1 run(s), first at ROM[2]",
+			differ:  true,
+			extra:   2,
+		},
+		{
+			name:    "old file longer",
+			old:     []uint16{a1, dEqM, a2},
+			new:     []uint16{a2, dEqM},
+			lines:   []string{"ROM[0]: ", "ROM[2] LOOP: "},
+			summary: "2 of 3 words differ (66.7%) in 2 run(s), first at ROM[0]",
+			differ:  true,
+			extra:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			out := bufio.NewWriter(&buf)
+			s := diff(out, asm.Standard(), labels, tt.old, tt.new)
+			out.Flush()
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if buf.Len() == 0 {
+				lines = nil
+			}
+			if len(lines) != len(tt.lines) {
+				t.Fatalf("got mismatch lines\n%s\nwant %d", buf.String(), len(tt.lines))
+			}
+			for i, want := range tt.lines {
+				if !strings.HasPrefix(lines[i], want) {
+					t.Errorf("line %d is %q, want it to start with %q", i, lines[i], want)
+				}
+			}
+			if s.differ() != tt.differ || s.extra != tt.extra {
+				t.Errorf("differ %v with %d extra words, want %v with %d", s.differ(), s.extra, tt.differ, tt.extra)
+			}
+
+			buf.Reset()
+			s.print(out, "old.hack", "new.hack")
+			out.Flush()
+			summary := strings.TrimPrefix(buf.String(), "\n")
+			if first, _, _ := strings.Cut(summary, "\n"); first != tt.summary {
+				t.Errorf("summary starts %q, want %q", first, tt.summary)
+			}
+		})
+	}
+}
+
+func TestStatsCount(t *testing.T) {
+	s := &stats{deltas: map[int]int{}}
+	s.count(16, 17)                                 // A value, +1
+	s.count(20, 21)                                 // A value, +1
+	s.count(30, 25)                                 // A value, -5
+	s.count(0b1111110000010000, 0b1110110000010000) // comp (the a bit)
+	s.count(0b1110110000010000, 0b1110110000011000) // dest
+	s.count(0b1110101010000111, 0b1110101010000010) // jump
+	s.count(0b1110101010000111, 0b1100101010000111) // prefix
+	s.count(5, 0b1110101010000111)                  // A/C kind
+	got := []int{s.aValue, s.cInstr, s.cComp, s.cDest, s.cJump, s.cOther, s.kind}
+	want := []int{3, 4, 1, 1, 1, 1, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("counts (A, C, comp, dest, jump, prefix, kind) = %v, want %v", got, want)
+		}
+	}
+	if deltas := s.topDeltas(1); deltas != "+1 ×2, ..." {
+		t.Errorf("topDeltas(1) = %q", deltas)
+	}
+}
@@ -0,0 +1,57 @@
+// hacklsp is a language server for Hack assembly. It speaks LSP over stdin and stdout,
+// offering diagnostics, go-to-definition, find-references, hover and completion.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"hack-assembler/asm"
+)
+
+func main() {
+	profile := flag.String("isa", "", "ISA profile adding to or overriding the standard Hack instructions and symbols")
+	lint := flag.Bool("lint", false, "also report warnings about instructions that misuse the A register")
+	defines := asm.Defines{}
+	flag.Var(defines, "D", "define `NAME=value` for .if, .ifdef and @NAME (repeatable)")
+	flag.Parse()
+
+	// stdout carries the protocol, so logging goes to stderr
+	log.SetOutput(os.Stderr)
+
+	a := asm.NewAssembler()
+	a.Defines = defines
+	if *profile != "" {
+		isa, err := asm.LoadISAFile(*profile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		a.ISA = isa
+	}
+
+	in := bufio.NewReader(os.Stdin)
+	s := NewServer(a, os.Stdout)
+	s.Lint = *lint
+	for {
+		body, err := readMessage(in)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		more, err := s.Handle(body)
+		if err != nil {
+			log.Println(err)
+		}
+		if !more {
+			if !s.shutdown {
+				os.Exit(1)
+			}
+			return
+		}
+	}
+}
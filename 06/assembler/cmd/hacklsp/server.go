@@ -0,0 +1,423 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	hackdiag "diagnostic"
+	"hack-assembler/asm"
+)
+
+// lspSeverity maps assembler severities to LSP DiagnosticSeverity values
+var lspSeverity = map[hackdiag.Severity]int{
+	hackdiag.Error:   severityError,
+	hackdiag.Warning: severityWarning,
+	hackdiag.Info:    severityInformation,
+}
+
+// document holds an open .asm file and the result of assembling it
+type document struct {
+	uri   string
+	text  string
+	rows  []string // the lines of text, starting at 0 as in LSP positions
+	prog  *asm.Program
+	lines map[int][]*asm.Command // commands by source line, starting at 1
+	// lines with errors, whose encoding is not meaningful
+	errLines map[int]bool
+}
+
+func newDocument(a *asm.Assembler, uri, text string) *document {
+	d := &document{
+		uri:      uri,
+		text:     text,
+		rows:     strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n"),
+		prog:     a.Assemble(strings.NewReader(text)),
+		lines:    map[int][]*asm.Command{},
+		errLines: map[int]bool{},
+	}
+	for i := range d.prog.Commands {
+		c := &d.prog.Commands[i]
+		d.lines[c.Line] = append(d.lines[c.Line], c)
+	}
+	for _, e := range d.prog.Diags {
+		if e.Severity == hackdiag.Error {
+			d.errLines[e.Line] = true
+		}
+	}
+	return d
+}
+
+// row returns line n of the text, starting at 0
+func (d *document) row(n int) string {
+	if n < 0 || n >= len(d.rows) {
+		return ""
+	}
+	return d.rows[n]
+}
+
+// character converts an assembler column, a byte offset starting at 1, to an LSP character
+// on line n, which counts UTF-16 code units from 0
+func (d *document) character(n, col int) int {
+	row := d.row(n)
+	b := col - 1
+	if b < 0 {
+		b = 0
+	}
+	if b > len(row) {
+		return utf16Len(row) + b - len(row)
+	}
+	return utf16Len(row[:b])
+}
+
+// column converts an LSP position to the assembler column it falls in
+func (d *document) column(pos position) int {
+	units := 0
+	row := d.row(pos.Line)
+	for i, r := range row {
+		if units >= pos.Character {
+			return i + 1
+		}
+		units += utf16RuneLen(r)
+	}
+	return len(row) + 1 + pos.Character - units
+}
+
+// utf16Len returns the length of s in UTF-16 code units
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16RuneLen(r)
+	}
+	return n
+}
+
+func utf16RuneLen(r rune) int {
+	if r > 0xFFFF && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+// commandAt returns the command under the cursor, and whether the cursor is on its symbol
+func (d *document) commandAt(pos position) (*asm.Command, bool) {
+	col := d.column(pos)
+	cmds := d.lines[pos.Line+1]
+	for i, c := range cmds {
+		if c.Symbol != "" && col >= c.SymCol && col <= c.SymCol+len(c.Symbol) {
+			return c, true
+		}
+		// Text has the spaces taken out, so the last command runs to the end of the code
+		end := c.Col + len(c.Text)
+		if i == len(cmds)-1 {
+			end = d.codeEnd(pos.Line + 1)
+		}
+		if col >= c.Col && col <= end {
+			return c, false
+		}
+	}
+	return nil, false
+}
+
+// codeEnd returns the column just past the code of an assembler line, before any comment
+func (d *document) codeEnd(line int) int {
+	_, end := asm.Bounds(d.row(line - 1))
+	return end + 1
+}
+
+// span returns the range of n bytes from an assembler line and column
+func (d *document) span(line, col, n int) textRange {
+	return textRange{
+		Start: position{Line: line - 1, Character: d.character(line-1, col)},
+		End:   position{Line: line - 1, Character: d.character(line-1, col+n)},
+	}
+}
+
+// symbolRange returns the range covered by the symbol of an A- or L-command
+func (d *document) symbolRange(c *asm.Command) textRange {
+	return d.span(c.Line, c.SymCol, len(c.Symbol))
+}
+
+// Server answers LSP requests for Hack assembly documents
+type Server struct {
+	asm      *asm.Assembler
+	out      io.Writer
+	docs     map[string]*document
+	shutdown bool
+	Lint     bool // also publish the assembler's lint warnings
+}
+
+// NewServer creates a Server that assembles documents with a and writes responses to out
+func NewServer(a *asm.Assembler, out io.Writer) *Server {
+	s := &Server{
+		asm:  a,
+		out:  out,
+		docs: map[string]*document{},
+	}
+	return s
+}
+
+// Handle processes one message, returning false once the client has asked the server to exit
+func (s *Server) Handle(body []byte) (bool, error) {
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return true, s.reply(nil, nil, &responseError{Code: errParse, Message: err.Error()})
+	}
+
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "initialize":
+		result = map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":   syncFull,
+				"definitionProvider": true,
+				"referencesProvider": true,
+				"hoverProvider":      true,
+				"completionProvider": map[string]interface{}{
+					"triggerCharacters": []string{"@", "=", ";"},
+				},
+			},
+			"serverInfo": map[string]string{"name": "hacklsp"},
+		}
+	case "shutdown":
+		s.shutdown = true
+	case "exit":
+		return false, nil
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			err = s.update(params.TextDocument.URI, params.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err = json.Unmarshal(req.Params, &params); err == nil && len(params.ContentChanges) > 0 {
+			text := params.ContentChanges[len(params.ContentChanges)-1].Text
+			err = s.update(params.TextDocument.URI, text)
+		}
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			delete(s.docs, params.TextDocument.URI)
+			err = s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []diagnostic{}})
+		}
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.definition(params)
+		}
+	case "textDocument/references":
+		var params referenceParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.references(params)
+		}
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.hover(params)
+		}
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.completion(params)
+		}
+	default:
+		if req.ID != nil {
+			return true, s.reply(req.ID, nil, &responseError{Code: errMethodNotFound, Message: "method not found: " + req.Method})
+		}
+		return true, nil
+	}
+
+	if req.ID == nil {
+		// notifications get no response
+		return true, err
+	}
+	if err != nil {
+		return true, s.reply(req.ID, nil, &responseError{Code: errInvalidParams, Message: err.Error()})
+	}
+	return true, s.reply(req.ID, result, nil)
+}
+
+// update reassembles a document and publishes its diagnostics
+func (s *Server) update(uri, text string) error {
+	d := newDocument(s.asm, uri, text)
+	if s.Lint {
+		d.prog.Diags = append(d.prog.Diags, d.prog.Lint()...)
+	}
+	s.docs[uri] = d
+
+	diags := []diagnostic{}
+	for _, e := range d.prog.Diags {
+		line := e.Line
+		if line < 1 {
+			line = 1
+		}
+		start := e.Column
+		if start < 1 {
+			start = 1
+		}
+		end := d.codeEnd(line)
+		if end <= start {
+			end = start + 1
+		}
+		msg := e.Message
+		if e.Fix != "" {
+			msg += " (" + e.Fix + ")"
+		}
+		diags = append(diags, diagnostic{
+			Range:    d.span(line, start, end-start),
+			Severity: lspSeverity[e.Severity],
+			Code:     e.Code,
+			Source:   "hack-assembler",
+			Message:  msg,
+		})
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: uri, Diagnostics: diags})
+}
+
+// definition finds where the label is declared, or where the variable is first used
+func (s *Server) definition(params textDocumentPositionParams) []location {
+	d, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	c, onSymbol := d.commandAt(params.Position)
+	if !onSymbol {
+		return nil
+	}
+	sym, ok := d.prog.Symbols.Lookup(c.Symbol)
+	if !ok || sym.Kind == asm.Predefined {
+		return nil
+	}
+	return []location{{URI: d.uri, Range: d.span(sym.Line, sym.Col, len(sym.Name))}}
+}
+
+// references finds every use of the symbol under the cursor, once for each place in the
+// source, however many times a .rept or .string repeats it
+func (s *Server) references(params referenceParams) []location {
+	d, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	c, onSymbol := d.commandAt(params.Position)
+	if !onSymbol {
+		return nil
+	}
+	locs := []location{}
+	seen := map[[2]int]bool{}
+	for i := range d.prog.Commands {
+		ref := &d.prog.Commands[i]
+		if ref.Symbol != c.Symbol || seen[[2]int{ref.Line, ref.SymCol}] {
+			continue
+		}
+		if ref.Type == asm.LCommand && !params.Context.IncludeDeclaration {
+			continue
+		}
+		seen[[2]int{ref.Line, ref.SymCol}] = true
+		locs = append(locs, location{URI: d.uri, Range: d.symbolRange(ref)})
+	}
+	return locs
+}
+
+// hover shows the resolved address of a symbol and the encoding of the instruction
+func (s *Server) hover(params textDocumentPositionParams) *hover {
+	d, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	c, onSymbol := d.commandAt(params.Position)
+	if c == nil {
+		return nil
+	}
+
+	var b strings.Builder
+	if onSymbol {
+		if sym, ok := d.prog.Symbols.Lookup(c.Symbol); ok {
+			_, key := asm.KeyCode(sym.Name)
+			switch {
+			case sym.Kind == asm.Predefined && key:
+				fmt.Fprintf(&b, "**%s** (%s) → keyboard code %d\n\n", sym.Name, sym.Kind, sym.Address)
+			case sym.Kind == asm.Label:
+				fmt.Fprintf(&b, "**%s** (%s) → ROM[%d]\n\n", sym.Name, sym.Kind, sym.Address)
+			default:
+				fmt.Fprintf(&b, "**%s** (%s) → RAM[%d]\n\n", sym.Name, sym.Kind, sym.Address)
+			}
+		}
+	}
+	if c.Type == asm.LCommand {
+		fmt.Fprintf(&b, "label for ROM address %d", c.Addr)
+	} else {
+		fmt.Fprintf(&b, "ROM[%d] `%s`", c.Addr, c.Text)
+		if c.Addr < len(d.prog.Code) && !d.errLines[c.Line] {
+			fmt.Fprintf(&b, " → `%s`", asm.FormatWord(d.prog.Code[c.Addr]))
+		}
+	}
+
+	h := &hover{Contents: markupContent{Kind: "markdown", Value: b.String()}}
+	if onSymbol {
+		r := d.symbolRange(c)
+		h.Range = &r
+	}
+	return h
+}
+
+// completion offers symbols after @, and mnemonics from the comp, dest and jump tables elsewhere
+func (s *Server) completion(params textDocumentPositionParams) []completionItem {
+	d, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	prefix := d.row(params.Position.Line)
+	if col := d.column(params.Position); col <= len(prefix) {
+		prefix = prefix[:col-1]
+	}
+
+	items := []completionItem{}
+	switch {
+	case strings.Contains(prefix, "@"):
+		for _, sym := range d.prog.Symbols.Symbols() {
+			kind := completionConstant
+			switch sym.Kind {
+			case asm.Label:
+				kind = completionLabel
+			case asm.Variable:
+				kind = completionVariable
+			}
+			items = append(items, completionItem{Label: sym.Name, Kind: kind, Detail: fmt.Sprintf("%s %d", sym.Kind, sym.Address)})
+		}
+	case strings.Contains(prefix, ";"):
+		for _, m := range d.prog.ISA.JumpMnemonics() {
+			items = append(items, completionItem{Label: m.Name, Kind: completionKeyword, Detail: "jump " + m.Bits})
+		}
+	case strings.Contains(prefix, "="):
+		for _, m := range d.prog.ISA.CompMnemonics() {
+			items = append(items, completionItem{Label: m.Name, Kind: completionKeyword, Detail: "comp " + m.Bits})
+		}
+	default:
+		for _, m := range d.prog.ISA.DestMnemonics() {
+			items = append(items, completionItem{Label: m.Name + "=", Kind: completionKeyword, Detail: "dest " + m.Bits, InsertText: m.Name + "="})
+		}
+		for _, m := range d.prog.ISA.CompMnemonics() {
+			items = append(items, completionItem{Label: m.Name, Kind: completionKeyword, Detail: "comp " + m.Bits})
+		}
+	}
+	return items
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}, rerr *responseError) error {
+	resp := response{JSONRPC: "2.0", ID: id, Error: rerr}
+	if rerr == nil {
+		body, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.Result = body
+	}
+	return writeMessage(s.out, resp)
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return writeMessage(s.out, notification{JSONRPC: "2.0", Method: method, Params: params})
+}
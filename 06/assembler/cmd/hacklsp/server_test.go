@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"hack-assembler/asm"
+)
+
+const testURI = "file:///test.asm"
+
+// client drives a Server through Handle and reads back what it writes
+type client struct {
+	t   *testing.T
+	s   *Server
+	out bytes.Buffer
+	id  int
+}
+
+func newClient(t *testing.T) *client {
+	c := &client{t: t}
+	c.s = NewServer(asm.NewAssembler(), &c.out)
+	return c
+}
+
+// send handles a message and returns the messages the server wrote
+func (c *client) send(method string, params interface{}, withID bool) []map[string]json.RawMessage {
+	c.t.Helper()
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if withID {
+		c.id++
+		msg["id"] = c.id
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if _, err := c.s.Handle(body); err != nil {
+		c.t.Fatal(err)
+	}
+	var msgs []map[string]json.RawMessage
+	r := bufio.NewReader(&c.out)
+	for {
+		body, err := readMessage(r)
+		if err == io.EOF {
+			return msgs
+		}
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(body, &m); err != nil {
+			c.t.Fatal(err)
+		}
+		msgs = append(msgs, m)
+	}
+}
+
+// request sends a request and decodes its result into result
+func (c *client) request(method string, params, result interface{}) {
+	c.t.Helper()
+	msgs := c.send(method, params, true)
+	if len(msgs) != 1 || msgs[0]["error"] != nil {
+		c.t.Fatalf("%s: got %d messages, %s", method, len(msgs), msgs)
+	}
+	if err := json.Unmarshal(msgs[0]["result"], result); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// open opens text as the test document and returns the diagnostics published for it
+func (c *client) open(text string) []diagnostic {
+	c.t.Helper()
+	return c.published(c.send("textDocument/didOpen", didOpenParams{TextDocument: textDocumentItem{URI: testURI, Text: text}}, false))
+}
+
+func (c *client) published(msgs []map[string]json.RawMessage) []diagnostic {
+	c.t.Helper()
+	if len(msgs) != 1 || string(msgs[0]["method"]) != `"textDocument/publishDiagnostics"` {
+		c.t.Fatalf("got %s, want diagnostics published", msgs)
+	}
+	var params publishDiagnosticsParams
+	if err := json.Unmarshal(msgs[0]["params"], &params); err != nil {
+		c.t.Fatal(err)
+	}
+	return params.Diagnostics
+}
+
+func at(line, character int) textDocumentPositionParams {
+	return textDocumentPositionParams{TextDocument: textDocumentIdentifier{URI: testURI}, Position: position{Line: line, Character: character}}
+}
+
+func TestDiagnostics(t *testing.T) {
+	c := newClient(t)
+	diags := c.open("@R0\nD=Q\n")
+	if len(diags) != 1 || diags[0].Code != "unknown-comp" || diags[0].Severity != severityError {
+		t.Fatalf("got %+v, want an unknown-comp error", diags)
+	}
+	want := textRange{Start: position{Line: 1, Character: 2}, End: position{Line: 1, Character: 3}}
+	if diags[0].Range != want {
+		t.Errorf("range %+v, want %+v", diags[0].Range, want)
+	}
+
+	change := didChangeParams{TextDocument: textDocumentIdentifier{URI: testURI}}
+	change.ContentChanges = append(change.ContentChanges, struct {
+		Text string `json:"text"`
+	}{"@R0\nD=M\n"})
+	if diags := c.published(c.send("textDocument/didChange", change, false)); len(diags) != 0 {
+		t.Errorf("after the fix got %+v, want no diagnostics", diags)
+	}
+
+	// the range runs to the end of the code as written, spaces and all, but not the comment
+	diags = c.open("DX = D + 1  // spaced\n")
+	want = textRange{Start: position{Line: 0, Character: 0}, End: position{Line: 0, Character: 10}}
+	if len(diags) != 1 || diags[0].Range != want {
+		t.Errorf("spaced command: got %+v, want one diagnostic at %+v", diags, want)
+	}
+}
+
+const labels = `@LOOP
+(LOOP)
+.rept 3
+@LOOP
+D;JGT
+.endr
+@LOOP
+0;JMP
+`
+
+func TestDefinition(t *testing.T) {
+	c := newClient(t)
+	c.open(labels)
+	var locs []location
+	c.request("textDocument/definition", at(6, 2), &locs)
+	want := textRange{Start: position{Line: 1, Character: 1}, End: position{Line: 1, Character: 5}}
+	if len(locs) != 1 || locs[0].Range != want {
+		t.Errorf("got %+v, want LOOP at %+v", locs, want)
+	}
+	c.request("textDocument/definition", at(4, 3), &locs)
+	if len(locs) != 0 {
+		t.Errorf("off a symbol got %+v, want none", locs)
+	}
+}
+
+func TestReferences(t *testing.T) {
+	c := newClient(t)
+	c.open(labels)
+	for _, declaration := range []bool{false, true} {
+		params := referenceParams{textDocumentPositionParams: at(0, 1)}
+		params.Context.IncludeDeclaration = declaration
+		var locs []location
+		c.request("textDocument/references", params, &locs)
+		var lines []int
+		for _, l := range locs {
+			lines = append(lines, l.Range.Start.Line)
+		}
+		// the use in the .rept block is listed once, not once for each repeat
+		want := []int{0, 3, 6}
+		if declaration {
+			want = []int{0, 1, 3, 6}
+		}
+		if len(lines) != len(want) {
+			t.Errorf("includeDeclaration %v: got lines %v, want %v", declaration, lines, want)
+			continue
+		}
+		for i := range want {
+			if lines[i] != want[i] {
+				t.Errorf("includeDeclaration %v: got lines %v, want %v", declaration, lines, want)
+				break
+			}
+		}
+	}
+}
+
+func TestHover(t *testing.T) {
+	c := newClient(t)
+	c.open(".string S \"ab\"\n@KEY_LEFT\n@SCREEN\n(END)\n@END\n@S\n")
+	tests := []struct {
+		pos      textDocumentPositionParams
+		contains string
+		onSymbol bool
+	}{
+		{at(1, 2), "**KEY_LEFT** (predefined) → keyboard code 130", true},
+		{at(2, 2), "**SCREEN** (predefined) → RAM[16384]", true},
+		{at(4, 2), "**END** (label) → ROM[", true},
+		{at(5, 1), "**S** (variable) → RAM[16]", true},
+		// the start of the directive is not on any of the symbols of its commands
+		{at(0, 0), "ROM[0] `@2`", false},
+		{at(0, 8), "**S** (variable) → RAM[16]", true},
+	}
+	for _, tt := range tests {
+		var h *hover
+		c.request("textDocument/hover", tt.pos, &h)
+		if h == nil || !strings.Contains(h.Contents.Value, tt.contains) {
+			t.Errorf("at %+v got %+v, want %q", tt.pos.Position, h, tt.contains)
+			continue
+		}
+		if (h.Range != nil) != tt.onSymbol {
+			t.Errorf("at %+v got range %+v, want one: %v", tt.pos.Position, h.Range, tt.onSymbol)
+		}
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	c := newClient(t)
+	c.open("(LOOP)\n@\nD=\n0;\n")
+	tests := []struct {
+		pos  textDocumentPositionParams
+		want string
+	}{
+		{at(1, 1), "LOOP"},
+		{at(1, 1), "KEY_ESC"},
+		{at(2, 2), "M+1"},
+		{at(3, 2), "JMP"},
+		{at(3, 0), "AM="},
+	}
+	for _, tt := range tests {
+		var items []completionItem
+		c.request("textDocument/completion", tt.pos, &items)
+		found := false
+		for _, item := range items {
+			found = found || item.Label == tt.want
+		}
+		if !found {
+			t.Errorf("at %+v: %s not offered", tt.pos.Position, tt.want)
+		}
+	}
+}
+
+// TestUTF16 checks that positions count UTF-16 code units, as LSP does, not bytes
+func TestUTF16(t *testing.T) {
+	c := newClient(t)
+	// é is 2 bytes and 1 unit, and 😀 is 4 bytes and 2 units
+	diags := c.open("@x // é😀\n@é\n")
+	want := textRange{Start: position{Line: 1, Character: 1}, End: position{Line: 1, Character: 2}}
+	if len(diags) != 1 || diags[0].Range != want {
+		t.Errorf("got %+v, want one diagnostic at %+v", diags, want)
+	}
+
+	d := c.s.docs[testURI]
+	row := d.row(0)
+	for _, tt := range []struct{ col, character int }{{1, 0}, {2, 1}, {7, 6}, {9, 7}, {len(row) + 1, 9}} {
+		if got := d.character(0, tt.col); got != tt.character {
+			t.Errorf("character(column %d) = %d, want %d", tt.col, got, tt.character)
+		}
+		if got := d.column(position{Line: 0, Character: tt.character}); got != tt.col {
+			t.Errorf("column(character %d) = %d, want %d", tt.character, got, tt.col)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// Package expr parses the expressions that the verifier's specs and the emulator's conditions
+// are written in. They are C expressions over 16-bit values:
+//
+//	R2 == R0*R1            names are left to the caller: symbols, registers, labels
+//	RAM[x + 1] > 5         RAM[expr] is a RAM cell
+//	old(R0) == R0          NAME(expr) is a call, such as the verifier's old()
+//	R1 < 10 ==> R2 != 0    ==> is implication, below ||
+//
+// Operators bind as in C, loosest first: ==>, ||, &&, the comparisons (== != < <= > >=),
+// + - | ^, * &, and the unary - ~ !. Numbers are decimal, or hexadecimal after 0x, and fit
+// in 16 bits. The caller gives the operators their meaning.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Node is a parsed expression
+type Node struct {
+	Op   string  // an operator, or "num", "name", "RAM" for RAM[x] or "call" for NAME(x)
+	Args []*Node // operands, one for a unary operator; the address of RAM; the argument of a call
+	Num  uint16  // the value of "num"
+	Name string  // the name of "name" and "call"
+}
+
+// Unary reports whether n is a unary operator: -, ~ or !
+func (n *Node) Unary() bool {
+	return len(n.Args) == 1 && n.Op != "RAM" && n.Op != "call"
+}
+
+// comparisons are the operators that compare two values
+var comparisons = []string{"==", "!=", "<", "<=", ">", ">="}
+
+// IsComparison reports whether op compares two values
+func IsComparison(op string) bool {
+	for _, c := range comparisons {
+		if op == c {
+			return true
+		}
+	}
+	return false
+}
+
+// parser reads one expression
+type parser struct {
+	toks []string
+	pos  int
+}
+
+// Parse parses an expression
+func Parse(text string) (*Node, error) {
+	toks, err := tokenize(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	n, err := p.implies()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q", p.toks[p.pos])
+	}
+	return n, nil
+}
+
+// operators are tried in order, so longer ones come before their prefixes
+var operators = []string{"==>", "==", "!=", "<=", ">=", "&&", "||", "<", ">", "+", "-", "*", "&", "|", "^", "~", "!", "(", ")", "[", "]"}
+
+// tokenize splits an expression into names, numbers and operators
+func tokenize(text string) ([]string, error) {
+	toks := []string{}
+	for i := 0; i < len(text); {
+		ch := text[i]
+		switch {
+		case ch == ' ' || ch == '\t':
+			i++
+		case isNameChar(ch):
+			j := i
+			for j < len(text) && isNameChar(text[j]) {
+				j++
+			}
+			toks = append(toks, text[i:j])
+			i = j
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(text[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q", ch)
+			}
+			toks = append(toks, op)
+			i += len(op)
+		}
+	}
+	return toks, nil
+}
+
+func isNameChar(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '_' || ch == '.' || ch == '$' || ch == ':'
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) expect(tok string) error {
+	if p.peek() != tok {
+		if p.peek() == "" {
+			return fmt.Errorf("expected %q at the end", tok)
+		}
+		return fmt.Errorf("expected %q, found %q", tok, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+// binary parses a left-associative chain of the operators ops over operands from next
+func (p *parser) binary(next func() (*Node, error), ops ...string) (*Node, error) {
+	x, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		found := false
+		for _, o := range ops {
+			found = found || o == op
+		}
+		if !found {
+			return x, nil
+		}
+		p.pos++
+		y, err := next()
+		if err != nil {
+			return nil, err
+		}
+		x = &Node{Op: op, Args: []*Node{x, y}}
+	}
+}
+
+func (p *parser) implies() (*Node, error) {
+	x, err := p.or()
+	if err != nil || p.peek() != "==>" {
+		return x, err
+	}
+	p.pos++
+	y, err := p.implies()
+	if err != nil {
+		return nil, err
+	}
+	return &Node{Op: "==>", Args: []*Node{x, y}}, nil
+}
+
+func (p *parser) or() (*Node, error) {
+	return p.binary(p.and, "||")
+}
+
+func (p *parser) and() (*Node, error) {
+	return p.binary(p.comparison, "&&")
+}
+
+// comparison parses one comparison at most, so a < b < c is an error rather than a surprise
+func (p *parser) comparison() (*Node, error) {
+	x, err := p.sum()
+	if err != nil || !IsComparison(p.peek()) {
+		return x, err
+	}
+	op := p.peek()
+	p.pos++
+	y, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	return &Node{Op: op, Args: []*Node{x, y}}, nil
+}
+
+func (p *parser) sum() (*Node, error) {
+	return p.binary(p.product, "+", "-", "|", "^")
+}
+
+func (p *parser) product() (*Node, error) {
+	return p.binary(p.unary, "*", "&")
+}
+
+func (p *parser) unary() (*Node, error) {
+	switch op := p.peek(); op {
+	case "-", "~", "!":
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: op, Args: []*Node{x}}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (*Node, error) {
+	tok := p.peek()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("expression ends too soon")
+	case tok == "(":
+		p.pos++
+		x, err := p.implies()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	case tok[0] >= '0' && tok[0] <= '9':
+		p.pos++
+		digits, base := tok, 10
+		if strings.HasPrefix(tok, "0x") {
+			digits, base = tok[2:], 16
+		}
+		v, err := strconv.ParseUint(digits, base, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a 16-bit number", tok)
+		}
+		return &Node{Op: "num", Num: uint16(v)}, nil
+	case tok == "RAM":
+		p.pos++
+		if err := p.expect("["); err != nil {
+			return nil, err
+		}
+		addr, err := p.implies()
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: "RAM", Args: []*Node{addr}}, p.expect("]")
+	case isNameChar(tok[0]):
+		p.pos++
+		if p.peek() != "(" {
+			return &Node{Op: "name", Name: tok}, nil
+		}
+		p.pos++
+		arg, err := p.implies()
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: "call", Name: tok, Args: []*Node{arg}}, p.expect(")")
+	}
+	return nil, fmt.Errorf("unexpected %q", tok)
+}
@@ -0,0 +1,70 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// format writes a tree back with every operator in parentheses
+func format(n *Node) string {
+	switch {
+	case n.Op == "num":
+		return strconv.Itoa(int(n.Num))
+	case n.Op == "name":
+		return n.Name
+	case n.Op == "RAM":
+		return "RAM[" + format(n.Args[0]) + "]"
+	case n.Op == "call":
+		return n.Name + "(" + format(n.Args[0]) + ")"
+	case n.Unary():
+		return "(" + n.Op + format(n.Args[0]) + ")"
+	}
+	return "(" + format(n.Args[0]) + " " + n.Op + " " + format(n.Args[1]) + ")"
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"R2 == R0*R1":              "(R2 == (R0 * R1))",
+		"1 + 2 * 3 - 4":            "((1 + (2 * 3)) - 4)",
+		"a | b & c ^ d":            "((a | (b & c)) ^ d)",
+		"-x * ~y":                  "((-x) * (~y))",
+		"!R0 == 0":                 "((!R0) == 0)",
+		"!(R0 == 0)":               "(!(R0 == 0))",
+		"a && b || c && d":         "((a && b) || (c && d))",
+		"a ==> b ==> c || d":       "(a ==> (b ==> (c || d)))",
+		"RAM[x + 1] >= 0x10":       "(RAM[(x + 1)] >= 16)",
+		"old(R0) != Main.f$ret.1":  "(old(R0) != Main.f$ret.1)",
+		"KBD == KEY_LEFT && D < 0": "((KBD == KEY_LEFT) && (D < 0))",
+	}
+	for text, want := range tests {
+		n, err := Parse(text)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+			continue
+		}
+		if got := format(n); got != want {
+			t.Errorf("%s parsed as %s, want %s", text, got, want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"":           "ends too soon",
+		"R0 ==":      "ends too soon",
+		"RAM[1":      `expected "]" at the end`,
+		"(1":         `expected ")" at the end`,
+		"old(R0 R1)": `expected ")", found "R1"`,
+		"1 2":        `unexpected "2"`,
+		"a < b < c":  `unexpected "<"`,
+		"R0 = 1":     "unexpected character '='",
+		"70000":      "not a 16-bit number",
+		"0xZZ":       "not a 16-bit number",
+	}
+	for text, want := range tests {
+		if _, err := Parse(text); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: got %v, want an error with %q", text, err, want)
+		}
+	}
+}
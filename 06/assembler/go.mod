@@ -1,3 +1,7 @@
 module hack-assembler
 
 go 1.20
+
+require diagnostic v0.0.0
+
+replace diagnostic => ../../diagnostic
@@ -1,219 +1,151 @@
 package main
 
 import (
-	"bufio"
+	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
-	"regexp"
-	"strconv"
+	"path/filepath"
 	"strings"
+
+	"diagnostic"
+	"hack-assembler/asm"
 )
 
 func main() {
-	filename := os.Args[1]
+	format := flag.String("format", diagnostic.FormatText, "diagnostic output format: text or json")
+	profile := flag.String("isa", "", "ISA profile adding to or overriding the standard Hack instructions and symbols")
+	symbols := flag.Bool("sym", false, "also write labels and variables to a .sym file next to the .hack file")
+	xref := flag.Bool("xref", false, "also write a cross-reference of symbol definitions and uses to a .xref file")
+	cfg := flag.String("cfg", "", "also write the control-flow graph next to the .hack file, as dot or json")
+	lint := flag.Bool("lint", false, "also warn about instructions that misuse the A register")
+	maxDiags := flag.Int("max-diagnostics", 100, "stop listing problems after this many (0 for no limit)")
+	defines := asm.Defines{}
+	flag.Var(defines, "D", "define `NAME=value` for .if, .ifdef and @NAME (repeatable; NAME alone means NAME=1)")
+	flag.Parse()
+	if flag.NArg() != 1 || !diagnostic.ValidFormat(*format) || (*cfg != "" && *cfg != "dot" && *cfg != "json") {
+		fmt.Fprintln(os.Stderr, "usage: assembler [--format=text|json] [--isa=profile] [--sym] [--xref] [--cfg=dot|json] [--lint] [--max-diagnostics=N] [-D NAME=value]... file.asm")
+		os.Exit(2)
+	}
+	filename := flag.Arg(0)
+
+	a := asm.NewAssembler()
+	a.Defines = defines
+	if *profile != "" {
+		isa, err := asm.LoadISAFile(*profile)
+		if err != nil {
+			d, ok := err.(diagnostic.Diagnostic)
+			if !ok {
+				d = diagnostic.Errorf(0, 0, "bad-profile", "%v", err)
+				d.File = *profile
+			}
+			os.Exit(report(*format, []diagnostic.Diagnostic{d}, *maxDiags))
+		}
+		a.ISA = isa
+	}
+	os.Exit(report(*format, assemble(a, filename, *symbols, *xref, *cfg, *lint, *format == diagnostic.FormatText), *maxDiags))
+}
 
+// assemble translates filename to a .hack file next to it, returning the problems found.
+// Nothing is written if there are errors, and files are replaced only once they are complete.
+func assemble(a *asm.Assembler, filename string, symbols, xref bool, cfg string, lint, verbose bool) []diagnostic.Diagnostic {
 	file, err := os.Open(filename)
 	if err != nil {
-		fmt.Println("Error reading filename: ", err)
-		return
+		d := diagnostic.Errorf(0, 0, "open-failed", "error reading file: %v", err)
+		d.File = filename
+		return []diagnostic.Diagnostic{d}
 	}
 	defer file.Close()
 
 	outFile := strings.TrimSuffix(filename, "asm") + "hack"
 
-	fmt.Printf("Translating %s\n", filename)
-	fmt.Printf("Machine code at %s\n", outFile)
-
-	//initialize Symbol Table and C/D/J Tables
-
-	symbolTable := map[string]int{
-		"R0":     0,
-		"R1":     1,
-		"R2":     2,
-		"R3":     3,
-		"R4":     4,
-		"R5":     5,
-		"R6":     6,
-		"R7":     7,
-		"R8":     8,
-		"R9":     9,
-		"R10":    10,
-		"R11":    11,
-		"R12":    12,
-		"R13":    13,
-		"R14":    14,
-		"R15":    15,
-		"SP":     0,
-		"LCL":    1,
-		"ARG":    2,
-		"THIS":   3,
-		"THAT":   4,
-		"SCREEN": 16384,
-		"KBD":    24576,
+	if verbose {
+		fmt.Printf("Translating %s\n", filename)
 	}
 
-	acTable := map[string]string{
-		"0":   "0101010",
-		"1":   "0111111",
-		"-1":  "0111010",
-		"D":   "0001100",
-		"A":   "0110000",
-		"M":   "1110000",
-		"!D":  "0001101",
-		"!A":  "0110001",
-		"!M":  "1110001",
-		"-D":  "0001111",
-		"-A":  "0110011",
-		"-M":  "1110011",
-		"D+1": "0011111",
-		"A+1": "0110111",
-		"M+1": "1110111",
-		"D-1": "0001110",
-		"A-1": "0110010",
-		"M-1": "1110010",
-		"D+A": "0000010",
-		"D+M": "1000010",
-		"D-A": "0010011",
-		"D-M": "1010011",
-		"A-D": "0000111",
-		"M-D": "1000111",
-		"D&A": "0000000",
-		"D&M": "1000000",
-		"D|A": "0010101",
-		"D|M": "1010101",
+	// First Pass: scan for labels
+	// Second Pass: translate each instruction, allocating variables
+	// Both passes carry on past bad lines, so every problem is reported in one run
+	prog := a.Assemble(file)
+	if lint {
+		prog.Diags = append(prog.Diags, prog.Lint()...)
 	}
-
-	dTable := map[string]string{
-		"":    "000",
-		"M":   "001",
-		"D":   "010",
-		"MD":  "011",
-		"A":   "100",
-		"AM":  "101",
-		"AD":  "110",
-		"AMD": "111",
+	diagnostic.SetFile(prog.Diags, filename)
+	if diagnostic.HasErrors(prog.Diags) {
+		return prog.Diags
 	}
 
-	jTable := map[string]string{
-		"":    "000",
-		"JGT": "001",
-		"JEQ": "010",
-		"JGE": "011",
-		"JLT": "100",
-		"JNE": "101",
-		"JLE": "110",
-		"JMP": "111",
+	base := strings.TrimSuffix(filename, "asm")
+	outputs := []output{{outFile, "output", prog.Write}}
+	if symbols {
+		outputs = append(outputs, output{base + "sym", "symbols", prog.WriteSymbols})
 	}
-
-	// First Pass
-	// Scan for Symbols (add to Symbol table and remove from code)
-
-	numLines := 0
-
-	tmp, err := os.CreateTemp("", "tmpfile-")
-	if err != nil {
-		log.Println(err)
+	if xref {
+		outputs = append(outputs, output{base + "xref", "cross-reference", prog.WriteXRef})
 	}
-	defer tmp.Close()
-	defer os.Remove(tmp.Name())
-
-	symPass := bufio.NewScanner(file)
-	for symPass.Scan() {
-		line := symPass.Text()
-		switch {
-		case len(line) > 0 && string(line[0]) == "(":
-			line = strings.Trim(line, "()")
-			symbolTable[line] = numLines
-		case len(line) > 0 && string(line[0:2]) != "//":
-			rmCmt := strings.Split(line, "//")
-			clean := strings.TrimSpace(rmCmt[0])
-			clean = clean + "\n"
-			if _, err := tmp.WriteString(clean); err != nil {
-				log.Println(err)
-			}
-			numLines++
+	switch cfg {
+	case "dot":
+		name := strings.TrimSuffix(filepath.Base(filename), ".asm")
+		outputs = append(outputs, output{base + "dot", "control-flow graph", func(w io.Writer) error {
+			return prog.CFG().WriteDOT(w, name)
+		}})
+	case "json":
+		outputs = append(outputs, output{base + "cfg.json", "control-flow graph", func(w io.Writer) error {
+			return prog.CFG().WriteJSON(w)
+		}})
+	}
+	for _, out := range outputs {
+		if err := writeFile(out.name, out.write); err != nil {
+			d := diagnostic.Errorf(0, 0, "write-failed", "error writing %s: %v", out.what, err)
+			d.File = out.name
+			return append(prog.Diags, d)
 		}
 	}
+	if verbose {
+		fmt.Printf("Machine code at %s\n", outFile)
+	}
+	return prog.Diags
+}
 
-	// Second Pass
-	// Translate each instruction
-	// Replace variables with Symbol Table value (or add to Symbol Table if first instance)
-
-	numLines = 0
-	newVar := 16
+// output is a file written after a successful assembly
+type output struct {
+	name  string
+	what  string
+	write func(io.Writer) error
+}
 
-	tf, err := os.OpenFile(outFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+// writeFile fills a temporary file with write and then renames it to name, so name is
+// never left holding partial output
+func writeFile(name string, write func(io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
 	if err != nil {
-		log.Println(err)
+		return err
 	}
-	defer tf.Close()
-
-	tmp.Seek(0, 0)
-	scanner := bufio.NewScanner(tmp)
-	for scanner.Scan() {
-		line := scanner.Text()
-		switch {
-		case len(line) > 0 && string(line[0]) == "@":
-			numLines++
-			location := line[1:]
-			if v, _ := regexp.MatchString(`^[0-9]+$`, location); !v {
-				if _, ok := symbolTable[location]; !ok {
-					symbolTable[location] = newVar
-					newVar++
-				}
-				location = strconv.Itoa(symbolTable[location])
-			}
-			v, _ := strconv.Atoi(location)
-			binRep := strconv.FormatInt(int64(v), 2)
-			extra := 16 - len(binRep)
-			for i := 0; i < extra; i++ {
-				binRep = "0" + binRep
-			}
-			binRep = binRep + "\n"
-			if _, err := tf.WriteString(binRep); err != nil {
-				log.Println(err)
-			}
-		case len(line) > 0 && string(line[0:2]) != "//":
-			numLines++
-			// dest = comp; jump
-			// 111 a cccccc ddd jjj
-			instr := "111"
-
-			cbits := strings.FieldsFunc(line, Delim)
-
-			dest := ""
-			comp := ""
-			jump := ""
-			switch {
-			case len(cbits) == 3:
-				dest = cbits[0]
-				comp = cbits[1]
-				jump = cbits[2]
-			case len(cbits) == 2 && strings.Contains(line, "="):
-				dest = cbits[0]
-				comp = cbits[1]
-			case len(cbits) == 2 && strings.Contains(line, ";"):
-				comp = cbits[0]
-				jump = cbits[1]
-			case len(cbits) == 1:
-				comp = cbits[0]
-			}
-
-			instr = instr + acTable[comp] + dTable[dest] + jTable[jump] + "\n"
-			if _, err := tf.WriteString(instr); err != nil {
-				log.Println(err)
-			}
-		}
-
+	defer os.Remove(f.Name())
+	if err := write(f); err != nil {
+		f.Close()
+		return err
 	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
 	}
-
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
 }
 
-func Delim(r rune) bool {
-	return string(r) == "=" || string(r) == ";"
+// report prints the first max diagnostics in source order (text to stderr, JSON to stdout)
+// and returns the exit status for the worst of all of them
+func report(format string, diags []diagnostic.Diagnostic, max int) int {
+	out := os.Stderr
+	if format == diagnostic.FormatJSON {
+		out = os.Stdout
+	}
+	diagnostic.Sort(diags)
+	if err := diagnostic.Write(out, format, diagnostic.Limit(diags, max)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return diagnostic.ExitCode(diags)
 }
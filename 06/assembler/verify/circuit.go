@@ -0,0 +1,212 @@
+package verify
+
+// word is a symbolic 16-bit value, one literal per bit, least significant first
+type word [16]lit
+
+// circuit builds gates over solver literals. Gates on constants are folded and identical
+// gates are shared, so concrete values stay concrete and repeated work costs nothing.
+type circuit struct {
+	s    *solver
+	ands map[[2]lit]lit
+	xors map[[2]lit]lit
+}
+
+func newCircuit() *circuit {
+	return &circuit{s: newSolver(), ands: map[[2]lit]lit{}, xors: map[[2]lit]lit{}}
+}
+
+func (c *circuit) and(x, y lit) lit {
+	switch {
+	case x == litFalse || y == litFalse || x == y.not():
+		return litFalse
+	case x == litTrue || x == y:
+		return y
+	case y == litTrue:
+		return x
+	}
+	if x > y {
+		x, y = y, x
+	}
+	key := [2]lit{x, y}
+	if z, ok := c.ands[key]; ok {
+		return z
+	}
+	z := c.s.newVar()
+	c.s.addClause(z.not(), x)
+	c.s.addClause(z.not(), y)
+	c.s.addClause(z, x.not(), y.not())
+	c.ands[key] = z
+	return z
+}
+
+func (c *circuit) or(x, y lit) lit {
+	return c.and(x.not(), y.not()).not()
+}
+
+func (c *circuit) xor(x, y lit) lit {
+	switch {
+	case x == litFalse:
+		return y
+	case y == litFalse:
+		return x
+	case x == litTrue:
+		return y.not()
+	case y == litTrue:
+		return x.not()
+	case x == y:
+		return litFalse
+	case x == y.not():
+		return litTrue
+	}
+	// share the gate between x^y, !x^y and so on
+	flip := x.neg() != y.neg()
+	x, y = x&^1, y&^1
+	if x > y {
+		x, y = y, x
+	}
+	key := [2]lit{x, y}
+	z, ok := c.xors[key]
+	if !ok {
+		z = c.s.newVar()
+		c.s.addClause(z.not(), x, y)
+		c.s.addClause(z.not(), x.not(), y.not())
+		c.s.addClause(z, x.not(), y)
+		c.s.addClause(z, x, y.not())
+		c.xors[key] = z
+	}
+	if flip {
+		return z.not()
+	}
+	return z
+}
+
+// mux is x if sel, otherwise y
+func (c *circuit) mux(sel, x, y lit) lit {
+	if x == y {
+		return x
+	}
+	return c.or(c.and(sel, x), c.and(sel.not(), y))
+}
+
+func constant(v uint16) word {
+	var w word
+	for i := range w {
+		w[i] = litFalse
+		if v>>i&1 == 1 {
+			w[i] = litTrue
+		}
+	}
+	return w
+}
+
+// fresh returns a word of new, unconstrained variables
+func (c *circuit) fresh() word {
+	var w word
+	for i := range w {
+		w[i] = c.s.newVar()
+	}
+	return w
+}
+
+// concrete returns the value of w if every bit is a constant
+func concrete(w word) (uint16, bool) {
+	var v uint16
+	for i, b := range w {
+		switch b {
+		case litTrue:
+			v |= 1 << i
+		case litFalse:
+		default:
+			return 0, false
+		}
+	}
+	return v, true
+}
+
+func (c *circuit) notWord(x word) word {
+	for i := range x {
+		x[i] = x[i].not()
+	}
+	return x
+}
+
+func (c *circuit) andWord(x, y word) word {
+	for i := range x {
+		x[i] = c.and(x[i], y[i])
+	}
+	return x
+}
+
+func (c *circuit) orWord(x, y word) word {
+	for i := range x {
+		x[i] = c.or(x[i], y[i])
+	}
+	return x
+}
+
+func (c *circuit) xorWord(x, y word) word {
+	for i := range x {
+		x[i] = c.xor(x[i], y[i])
+	}
+	return x
+}
+
+func (c *circuit) muxWord(sel lit, x, y word) word {
+	for i := range x {
+		x[i] = c.mux(sel, x[i], y[i])
+	}
+	return x
+}
+
+// add is a ripple-carry adder; the carry out of bit 15 is dropped, as in the Hack ALU
+func (c *circuit) add(x, y word, carry lit) word {
+	var sum word
+	for i := range x {
+		half := c.xor(x[i], y[i])
+		sum[i] = c.xor(half, carry)
+		carry = c.or(c.and(x[i], y[i]), c.and(half, carry))
+	}
+	return sum
+}
+
+func (c *circuit) sub(x, y word) word {
+	return c.add(x, c.notWord(y), litTrue)
+}
+
+func (c *circuit) neg(x word) word {
+	return c.add(constant(0), c.notWord(x), litTrue)
+}
+
+// mul multiplies by shifting and adding, keeping the low 16 bits
+func (c *circuit) mul(x, y word) word {
+	product := constant(0)
+	for i := range y {
+		var partial word
+		for j := range partial {
+			partial[j] = litFalse
+			if j >= i {
+				partial[j] = c.and(x[j-i], y[i])
+			}
+		}
+		product = c.add(product, partial, litFalse)
+	}
+	return product
+}
+
+func (c *circuit) isZero(x word) lit {
+	z := litTrue
+	for _, b := range x {
+		z = c.and(z, b.not())
+	}
+	return z
+}
+
+func (c *circuit) eq(x, y word) lit {
+	return c.isZero(c.xorWord(x, y))
+}
+
+// less compares x and y as signed 16-bit numbers
+func (c *circuit) less(x, y word) lit {
+	diff := c.sub(x, y)
+	return c.mux(c.xor(x[15], y[15]), x[15], diff[15])
+}
@@ -0,0 +1,505 @@
+package verify
+
+import "sort"
+
+// lit is a literal: variable v as 2v, and its negation as 2v+1
+type lit uint32
+
+func (l lit) not() lit  { return l ^ 1 }
+func (l lit) v() int    { return int(l >> 1) }
+func (l lit) neg() bool { return l&1 == 1 }
+
+// variable 0 is the constant true
+const (
+	litTrue  lit = 0
+	litFalse lit = 1
+	litUndef lit = ^lit(0)
+)
+
+// clause is a disjunction of literals. In a clause that is the reason for an assignment,
+// lits[0] is the literal it implied; lits[0] and lits[1] are the watched literals.
+type clause struct {
+	lits     []lit
+	learnt   bool
+	deleted  bool
+	activity float64
+}
+
+// solver is a small CDCL SAT solver in the style of MiniSat: two watched literals, 1-UIP
+// learning with clause minimization, VSIDS with phase saving, Luby restarts and solving
+// under assumptions, so one solver can answer many queries about the same circuit.
+type solver struct {
+	clauses  []*clause
+	learnts  []*clause
+	watches  [][]*clause // by literal, the clauses to visit when it becomes false
+	assigns  []int8      // by variable: 0 unassigned, 1 true, -1 false
+	level    []int
+	reason   []*clause
+	trail    []lit
+	trailLim []int
+	qhead    int
+
+	activity []float64
+	varInc   float64
+	claInc   float64
+	order    varHeap
+	phase    []bool // saved polarity, true for negative
+	seen     []bool
+
+	model      []bool
+	ok         bool
+	maxLearnts float64
+	conflicts  int
+}
+
+func newSolver() *solver {
+	s := &solver{varInc: 1, claInc: 1, ok: true, maxLearnts: 2000}
+	s.order.act = &s.activity
+	s.newVar()
+	s.enqueue(litTrue, nil)
+	return s
+}
+
+// newVar adds a variable and returns its positive literal
+func (s *solver) newVar() lit {
+	v := len(s.assigns)
+	s.watches = append(s.watches, nil, nil)
+	s.assigns = append(s.assigns, 0)
+	s.level = append(s.level, 0)
+	s.reason = append(s.reason, nil)
+	s.activity = append(s.activity, 0)
+	s.phase = append(s.phase, true)
+	s.seen = append(s.seen, false)
+	s.order.insert(v)
+	return lit(v << 1)
+}
+
+func (s *solver) value(l lit) int8 {
+	a := s.assigns[l.v()]
+	if l.neg() {
+		return -a
+	}
+	return a
+}
+
+func (s *solver) decisionLevel() int {
+	return len(s.trailLim)
+}
+
+// addClause adds a clause at decision level 0; it returns false if the clauses are now unsatisfiable
+func (s *solver) addClause(lits ...lit) bool {
+	if !s.ok {
+		return false
+	}
+	ls := append([]lit(nil), lits...)
+	sort.Slice(ls, func(i, j int) bool { return ls[i] < ls[j] })
+	j := 0
+	for i, l := range ls {
+		switch {
+		case s.value(l) == 1 || (i > 0 && l == ls[i-1].not()):
+			return true
+		case s.value(l) == -1 || (i > 0 && l == ls[i-1]):
+			continue
+		}
+		ls[j] = l
+		j++
+	}
+	ls = ls[:j]
+	switch len(ls) {
+	case 0:
+		s.ok = false
+	case 1:
+		s.enqueue(ls[0], nil)
+		s.ok = s.propagate() == nil
+	default:
+		c := &clause{lits: ls}
+		s.clauses = append(s.clauses, c)
+		s.attach(c)
+	}
+	return s.ok
+}
+
+func (s *solver) attach(c *clause) {
+	s.watches[c.lits[0]] = append(s.watches[c.lits[0]], c)
+	s.watches[c.lits[1]] = append(s.watches[c.lits[1]], c)
+}
+
+func (s *solver) enqueue(l lit, from *clause) {
+	v := l.v()
+	if l.neg() {
+		s.assigns[v] = -1
+	} else {
+		s.assigns[v] = 1
+	}
+	s.level[v] = s.decisionLevel()
+	s.reason[v] = from
+	s.trail = append(s.trail, l)
+}
+
+// propagate makes the unit implications of the trail, returning a conflicting clause if any
+func (s *solver) propagate() *clause {
+	for s.qhead < len(s.trail) {
+		falseLit := s.trail[s.qhead].not()
+		s.qhead++
+		ws := s.watches[falseLit]
+		i, j := 0, 0
+		for i < len(ws) {
+			c := ws[i]
+			i++
+			if c.deleted {
+				continue
+			}
+			if c.lits[0] == falseLit {
+				c.lits[0], c.lits[1] = c.lits[1], c.lits[0]
+			}
+			if s.value(c.lits[0]) == 1 {
+				ws[j] = c
+				j++
+				continue
+			}
+			moved := false
+			for k := 2; k < len(c.lits); k++ {
+				if s.value(c.lits[k]) != -1 {
+					c.lits[1], c.lits[k] = c.lits[k], c.lits[1]
+					s.watches[c.lits[1]] = append(s.watches[c.lits[1]], c)
+					moved = true
+					break
+				}
+			}
+			if moved {
+				continue
+			}
+			ws[j] = c
+			j++
+			if s.value(c.lits[0]) == -1 {
+				j += copy(ws[j:], ws[i:])
+				s.watches[falseLit] = ws[:j]
+				s.qhead = len(s.trail)
+				return c
+			}
+			s.enqueue(c.lits[0], c)
+		}
+		s.watches[falseLit] = ws[:j]
+	}
+	return nil
+}
+
+// analyze derives a 1-UIP clause from a conflict, returning it and the level to go back to
+func (s *solver) analyze(confl *clause) ([]lit, int) {
+	learnt := []lit{litUndef}
+	pathC := 0
+	p := litUndef
+	index := len(s.trail) - 1
+	for {
+		if confl.learnt {
+			s.bumpClause(confl)
+		}
+		start := 0
+		if p != litUndef {
+			start = 1
+		}
+		for _, q := range confl.lits[start:] {
+			v := q.v()
+			if s.seen[v] || s.level[v] == 0 {
+				continue
+			}
+			s.bumpVar(v)
+			s.seen[v] = true
+			if s.level[v] >= s.decisionLevel() {
+				pathC++
+			} else {
+				learnt = append(learnt, q)
+			}
+		}
+		for !s.seen[s.trail[index].v()] {
+			index--
+		}
+		p = s.trail[index]
+		index--
+		confl = s.reason[p.v()]
+		s.seen[p.v()] = false
+		pathC--
+		if pathC == 0 {
+			break
+		}
+	}
+	learnt[0] = p.not()
+
+	// drop literals implied by the rest of the clause
+	marked := append([]lit(nil), learnt[1:]...)
+	j := 1
+	for _, q := range learnt[1:] {
+		r := s.reason[q.v()]
+		redundant := r != nil
+		if r != nil {
+			for _, l := range r.lits[1:] {
+				if !s.seen[l.v()] && s.level[l.v()] > 0 {
+					redundant = false
+					break
+				}
+			}
+		}
+		if !redundant {
+			learnt[j] = q
+			j++
+		}
+	}
+	learnt = learnt[:j]
+	for _, q := range marked {
+		s.seen[q.v()] = false
+	}
+
+	// watch the literal from the highest level after the asserting one
+	bt := 0
+	if len(learnt) > 1 {
+		max := 1
+		for i := 2; i < len(learnt); i++ {
+			if s.level[learnt[i].v()] > s.level[learnt[max].v()] {
+				max = i
+			}
+		}
+		learnt[1], learnt[max] = learnt[max], learnt[1]
+		bt = s.level[learnt[1].v()]
+	}
+	return learnt, bt
+}
+
+func (s *solver) backtrack(level int) {
+	if s.decisionLevel() <= level {
+		return
+	}
+	for i := len(s.trail) - 1; i >= s.trailLim[level]; i-- {
+		l := s.trail[i]
+		v := l.v()
+		s.assigns[v] = 0
+		s.reason[v] = nil
+		s.phase[v] = l.neg()
+		if !s.order.contains(v) {
+			s.order.insert(v)
+		}
+	}
+	s.trail = s.trail[:s.trailLim[level]]
+	s.trailLim = s.trailLim[:level]
+	s.qhead = len(s.trail)
+}
+
+func (s *solver) bumpVar(v int) {
+	s.activity[v] += s.varInc
+	if s.activity[v] > 1e100 {
+		for i := range s.activity {
+			s.activity[i] *= 1e-100
+		}
+		s.varInc *= 1e-100
+	}
+	if s.order.contains(v) {
+		s.order.up(s.order.indices[v])
+	}
+}
+
+func (s *solver) bumpClause(c *clause) {
+	c.activity += s.claInc
+	if c.activity > 1e20 {
+		for _, l := range s.learnts {
+			l.activity *= 1e-20
+		}
+		s.claInc *= 1e-20
+	}
+}
+
+// reduceDB forgets the less active half of the learnt clauses that are not reasons
+func (s *solver) reduceDB() {
+	sort.Slice(s.learnts, func(i, j int) bool { return s.learnts[i].activity < s.learnts[j].activity })
+	kept := s.learnts[:0]
+	for i, c := range s.learnts {
+		locked := s.reason[c.lits[0].v()] == c && s.value(c.lits[0]) == 1
+		if i < len(s.learnts)/2 && len(c.lits) > 2 && !locked {
+			c.deleted = true
+			continue
+		}
+		kept = append(kept, c)
+	}
+	s.learnts = kept
+	s.maxLearnts *= 1.1
+}
+
+// search runs CDCL until it finds an answer or has seen budget conflicts.
+// It returns 1 for satisfiable, -1 for unsatisfiable and 0 to restart.
+func (s *solver) search(budget int, assumptions []lit) int {
+	conflicts := 0
+	for {
+		if confl := s.propagate(); confl != nil {
+			s.conflicts++
+			conflicts++
+			if s.decisionLevel() == 0 {
+				s.ok = false
+				return -1
+			}
+			learnt, bt := s.analyze(confl)
+			s.backtrack(bt)
+			if len(learnt) == 1 {
+				s.enqueue(learnt[0], nil)
+			} else {
+				c := &clause{lits: learnt, learnt: true}
+				s.learnts = append(s.learnts, c)
+				s.attach(c)
+				s.bumpClause(c)
+				s.enqueue(learnt[0], c)
+			}
+			s.varInc /= 0.95
+			s.claInc /= 0.999
+			continue
+		}
+		if conflicts >= budget {
+			s.backtrack(0)
+			return 0
+		}
+		if float64(len(s.learnts)-len(s.trail)) >= s.maxLearnts {
+			s.reduceDB()
+		}
+
+		next := litUndef
+		for s.decisionLevel() < len(assumptions) {
+			p := assumptions[s.decisionLevel()]
+			if s.value(p) == 1 {
+				s.trailLim = append(s.trailLim, len(s.trail))
+				continue
+			}
+			if s.value(p) == -1 {
+				return -1
+			}
+			next = p
+			break
+		}
+		if next == litUndef {
+			v := s.pickBranchVar()
+			if v < 0 {
+				s.model = make([]bool, len(s.assigns))
+				for i, a := range s.assigns {
+					s.model[i] = a == 1
+				}
+				return 1
+			}
+			next = lit(v << 1)
+			if s.phase[v] {
+				next = next.not()
+			}
+		}
+		s.trailLim = append(s.trailLim, len(s.trail))
+		s.enqueue(next, nil)
+	}
+}
+
+func (s *solver) pickBranchVar() int {
+	for !s.order.empty() {
+		v := s.order.removeMax()
+		if s.assigns[v] == 0 {
+			return v
+		}
+	}
+	return -1
+}
+
+// solve reports whether the clauses and the assumptions can all be satisfied.
+// If they can, modelValue gives the satisfying assignment.
+func (s *solver) solve(assumptions ...lit) bool {
+	if !s.ok {
+		return false
+	}
+	s.model = nil
+	result := 0
+	for restart := 1; result == 0; restart++ {
+		result = s.search(100*luby(restart), assumptions)
+	}
+	s.backtrack(0)
+	return result == 1
+}
+
+// modelValue returns the value of l in the last satisfying assignment
+func (s *solver) modelValue(l lit) bool {
+	return s.model[l.v()] != l.neg()
+}
+
+// luby returns the i-th term (from 1) of the Luby sequence 1 1 2 1 1 2 4 ...
+func luby(i int) int {
+	size, seq := 1, 0
+	for size < i+1 {
+		seq++
+		size = 2*size + 1
+	}
+	x := i - 1
+	for size-1 != x {
+		size = (size - 1) >> 1
+		seq--
+		x %= size
+	}
+	return 1 << seq
+}
+
+// varHeap orders the unassigned variables by activity
+type varHeap struct {
+	act     *[]float64
+	heap    []int
+	indices []int // position of each variable in heap, or -1
+}
+
+func (h *varHeap) less(a, b int) bool { return (*h.act)[h.heap[a]] > (*h.act)[h.heap[b]] }
+func (h *varHeap) empty() bool        { return len(h.heap) == 0 }
+
+func (h *varHeap) contains(v int) bool {
+	return v < len(h.indices) && h.indices[v] >= 0
+}
+
+func (h *varHeap) insert(v int) {
+	for len(h.indices) <= v {
+		h.indices = append(h.indices, -1)
+	}
+	h.indices[v] = len(h.heap)
+	h.heap = append(h.heap, v)
+	h.up(len(h.heap) - 1)
+}
+
+func (h *varHeap) removeMax() int {
+	v := h.heap[0]
+	last := len(h.heap) - 1
+	h.swap(0, last)
+	h.heap = h.heap[:last]
+	h.indices[v] = -1
+	if last > 0 {
+		h.down(0)
+	}
+	return v
+}
+
+func (h *varHeap) swap(i, j int) {
+	h.heap[i], h.heap[j] = h.heap[j], h.heap[i]
+	h.indices[h.heap[i]] = i
+	h.indices[h.heap[j]] = j
+}
+
+func (h *varHeap) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *varHeap) down(i int) {
+	for {
+		child := 2*i + 1
+		if child >= len(h.heap) {
+			return
+		}
+		if child+1 < len(h.heap) && h.less(child+1, child) {
+			child++
+		}
+		if !h.less(child, i) {
+			return
+		}
+		h.swap(i, child)
+		i = child
+	}
+}
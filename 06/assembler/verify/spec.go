@@ -0,0 +1,126 @@
+package verify
+
+import (
+	"fmt"
+
+	"hack-assembler/asm"
+	"hack-assembler/expr"
+)
+
+// Conditions are written like C expressions over 16-bit values, in the syntax of package expr:
+//
+//	R2 == R0*R1                    values are RAM cells by symbol (R0, SP, a variable), RAM[n],
+//	R0 >= 0 && R1 >= 0             the registers A and D, and numbers
+//	old(R0) == R0                  in a postcondition, old(X) is the value X started with
+//	R1 < 10 ==> R2 != 0            ==> is implication
+//
+// Arithmetic (+ - * & | ^ ~ and unary -) wraps at 16 bits, and comparisons are signed,
+// as on the Hack ALU. Conditions and values are kept apart: ! && || and ==> join conditions,
+// and the rest work on values.
+
+// node is a parsed condition or value
+type node struct {
+	op      string // operator, or "num", "ram", "reg"
+	args    []*node
+	num     uint16
+	addr    int    // for "ram"
+	reg     byte   // 'A' or 'D', for "reg"
+	old     bool   // the initial value, for "ram" and "reg"
+	boolean bool   // a condition rather than a value
+	text    string // source, for messages
+}
+
+// parseCondition parses a condition, resolving names with the program's symbols
+func parseCondition(text string, syms *asm.SymbolTable, post bool) (*node, error) {
+	e, err := expr.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	n, err := convert(e, syms, post)
+	if err != nil {
+		return nil, err
+	}
+	if !n.boolean {
+		return nil, fmt.Errorf("%q is a value, not a condition; compare it with something", text)
+	}
+	n.text = text
+	return n, nil
+}
+
+// convert checks a parsed expression for a spec and resolves its names; old() is allowed if
+// post is set
+func convert(e *expr.Node, syms *asm.SymbolTable, post bool) (*node, error) {
+	switch e.Op {
+	case "num":
+		return &node{op: "num", num: e.Num}, nil
+	case "RAM":
+		addr := e.Args[0]
+		if addr.Op != "num" || addr.Num > 32767 {
+			return nil, fmt.Errorf("RAM[]: the address must be a number from 0 to 32767")
+		}
+		return &node{op: "ram", addr: int(addr.Num)}, nil
+	case "name":
+		if e.Name == "A" || e.Name == "D" {
+			return &node{op: "reg", reg: e.Name[0]}, nil
+		}
+		sym, ok := syms.Lookup(e.Name)
+		if !ok {
+			return nil, fmt.Errorf("unknown symbol %s", e.Name)
+		}
+		if sym.Kind == asm.Label {
+			return nil, fmt.Errorf("%s is a label in ROM, not a RAM cell", e.Name)
+		}
+		return &node{op: "ram", addr: sym.Address}, nil
+	case "call":
+		if e.Name != "old" {
+			return nil, fmt.Errorf("unknown function %s; the only one is old()", e.Name)
+		}
+		if !post {
+			return nil, fmt.Errorf("old() is only for postconditions; a precondition is about the initial values already")
+		}
+		x, err := convert(e.Args[0], syms, post)
+		if err != nil {
+			return nil, err
+		}
+		if x.op != "ram" && x.op != "reg" {
+			return nil, fmt.Errorf("old() takes a RAM cell or register")
+		}
+		x.old = true
+		return x, nil
+	}
+
+	args := []*node{}
+	for _, a := range e.Args {
+		x, err := convert(a, syms, post)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, x)
+	}
+	switch {
+	case e.Op == "!":
+		if !args[0].boolean {
+			return nil, fmt.Errorf("! works on conditions; use ~ for bitwise not")
+		}
+		return &node{op: "!", args: args, boolean: true}, nil
+	case e.Unary():
+		if args[0].boolean {
+			return nil, fmt.Errorf("%s works on values, not conditions", e.Op)
+		}
+		return &node{op: "neg" + e.Op, args: args}, nil
+	case e.Op == "==>" || e.Op == "||" || e.Op == "&&":
+		if !args[0].boolean || !args[1].boolean {
+			return nil, fmt.Errorf("%s joins conditions, not values", e.Op)
+		}
+		return &node{op: e.Op, args: args, boolean: true}, nil
+	case expr.IsComparison(e.Op):
+		if args[0].boolean || args[1].boolean {
+			return nil, fmt.Errorf("%s compares values, not conditions", e.Op)
+		}
+		return &node{op: e.Op, args: args, boolean: true}, nil
+	}
+	if args[0].boolean || args[1].boolean {
+		return nil, fmt.Errorf("%s works on values, not conditions", e.Op)
+	}
+	return &node{op: e.Op, args: args}, nil
+}
@@ -0,0 +1,470 @@
+// Package verify proves properties of small Hack routines by bounded model checking.
+//
+// The assembled words are executed symbolically: every RAM cell and register starts as an
+// unknown 16-bit value, the ALU is modelled bit for bit, and execution splits in two at each
+// jump whose outcome depends on the unknowns. Every path that halts within the step bound is
+// checked against the postconditions with a SAT solver, under the preconditions and the
+// branch decisions that lead down the path. A violation comes back as concrete initial values.
+//
+// A program halts when it jumps to itself (the usual "(END) @END 0;JMP"), reaches the halt
+// label, or runs off the end of the code. M and jump targets must be concrete addresses: code
+// that computes addresses from the unknowns (pointers) is reported as unsupported.
+package verify
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"diagnostic"
+	"hack-assembler/asm"
+)
+
+// maxPaths stops runaway exploration, such as a loop that branches on every iteration
+const maxPaths = 100000
+
+// Spec is what a routine must do
+type Spec struct {
+	Pre   []string // conditions on the initial state, all assumed
+	Post  []string // conditions on the final state, all checked
+	Bound int      // maximum instructions executed on any path
+	Halt  string   // optional label where the routine ends
+}
+
+// Value is a RAM cell or register in a counterexample
+type Value struct {
+	Name  string // symbol, Rn, RAM[n], A or D
+	Addr  int    // RAM address, or -1 for a register
+	Value int16
+}
+
+// Counterexample is an initial state from which the routine breaks a postcondition
+type Counterexample struct {
+	Initial []Value // the unknowns the path depends on
+	Final   []Value // cells the path writes, as they are when it halts
+	Failed  []string
+	Steps   int
+	PC      int // where the path halted
+}
+
+// Result is the outcome of a check
+type Result struct {
+	Paths          int  // paths that halted within the bound
+	Truncated      int  // paths still running at the bound, which are not checked
+	Vacuous        bool // no initial state satisfies the preconditions
+	Counterexample *Counterexample
+}
+
+// state is one path through the program
+type state struct {
+	pc    int
+	steps int
+	a, d  word
+	ram   map[int]word // cells written on this path
+	path  []lit        // branch decisions taken
+}
+
+func (s *state) fork() *state {
+	t := *s
+	t.ram = make(map[int]word, len(s.ram))
+	for addr, w := range s.ram {
+		t.ram[addr] = w
+	}
+	t.path = append([]lit{}, s.path...)
+	return &t
+}
+
+// checker holds what is shared by every path
+type checker struct {
+	prog         *asm.Program
+	c            *circuit
+	init         map[int]word // initial RAM, created as it is read
+	initA, initD word
+	usedA, usedD bool
+	halt         int
+}
+
+// Check explores every path of the program from ROM[0] up to the bound and checks the
+// postconditions where each path halts. It stops at the first counterexample.
+func Check(prog *asm.Program, spec Spec) (*Result, error) {
+	if diagnostic.HasErrors(prog.Diags) {
+		return nil, fmt.Errorf("the program has assembly errors")
+	}
+	if spec.Bound <= 0 {
+		return nil, fmt.Errorf("the step bound must be positive")
+	}
+	k := &checker{prog: prog, c: newCircuit(), init: map[int]word{}, halt: -1}
+	k.initA, k.initD = k.c.fresh(), k.c.fresh()
+	if spec.Halt != "" {
+		sym, ok := prog.Symbols.Lookup(spec.Halt)
+		if !ok || sym.Kind != asm.Label {
+			return nil, fmt.Errorf("halt label %s is not declared", spec.Halt)
+		}
+		k.halt = sym.Address
+	}
+
+	pres, err := k.conditions(spec.Pre, false)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := k.conditions(spec.Post, true)
+	if err != nil {
+		return nil, err
+	}
+	initial := &state{a: k.initA, d: k.initD, ram: map[int]word{}}
+	pre := litTrue
+	for _, n := range pres {
+		pre = k.c.and(pre, k.eval(n, initial).cond)
+	}
+
+	result := &Result{}
+	if !k.c.s.solve(pre) {
+		result.Vacuous = true
+		return result, nil
+	}
+	stack := []*state{initial}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		next, halted, err := k.run(s, pre, spec.Bound)
+		if err != nil {
+			return nil, err
+		}
+		if !halted {
+			if next == nil {
+				result.Truncated++
+			} else {
+				stack = append(stack, s, next)
+				if result.Paths+result.Truncated+len(stack) > maxPaths {
+					return nil, fmt.Errorf("more than %d paths; tighten the preconditions or lower the bound", maxPaths)
+				}
+			}
+			continue
+		}
+		result.Paths++
+		if cex := k.checkPost(s, pre, posts); cex != nil {
+			result.Counterexample = cex
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// conditions parses the conditions of a spec
+func (k *checker) conditions(texts []string, post bool) ([]*node, error) {
+	nodes := []*node{}
+	for _, text := range texts {
+		n, err := parseCondition(text, k.prog.Symbols, post)
+		if err != nil {
+			kind := "precondition"
+			if post {
+				kind = "postcondition"
+			}
+			return nil, fmt.Errorf("%s %q: %v", kind, text, err)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// run executes s until it halts, reaches the bound (halted false, next nil) or splits at a
+// jump (halted false, next the path that jumps; s carries on from the next instruction)
+func (k *checker) run(s *state, pre lit, bound int) (next *state, halted bool, err error) {
+	c := k.c
+	for {
+		if s.pc >= len(k.prog.Code) || s.pc == k.halt && s.steps > 0 {
+			return nil, true, nil
+		}
+		if s.steps >= bound {
+			return nil, false, nil
+		}
+		instr := k.prog.Code[s.pc]
+		s.steps++
+		if instr&0x8000 == 0 {
+			s.a = constant(instr)
+			s.pc++
+			continue
+		}
+		if instr&0xE000 != 0xE000 {
+			return nil, false, fmt.Errorf("ROM[%d]: %016b is not a standard Hack C-instruction", s.pc, instr)
+		}
+
+		readsM := instr&0x1000 != 0
+		writesM := instr&0x0008 != 0
+		jumps := instr&0x0007 != 0
+		addr := -1
+		if readsM || writesM || jumps {
+			v, ok := concrete(s.a)
+			if !ok {
+				return nil, false, fmt.Errorf("ROM[%d]: A holds a computed address, which is not supported", s.pc)
+			}
+			addr = int(v & 0x7FFF)
+		}
+		// the ALU ignores x when zx is set and y when zy is set
+		if s.a == k.initA && (readsM || writesM || jumps || instr&0x0200 == 0) {
+			k.usedA = true
+		}
+		if s.d == k.initD && instr&0x0800 == 0 {
+			k.usedD = true
+		}
+
+		y := s.a
+		if readsM {
+			y = k.read(s, addr)
+		}
+		out := k.alu(s.d, y, instr>>6&0x3F)
+		if writesM {
+			s.ram[addr] = out
+		}
+		if instr&0x0020 != 0 {
+			s.a = out
+		}
+		if instr&0x0010 != 0 {
+			s.d = out
+		}
+
+		taken := litFalse
+		if jumps {
+			zr, ng := c.isZero(out), out[15]
+			if instr&0x4 != 0 {
+				taken = c.or(taken, ng)
+			}
+			if instr&0x2 != 0 {
+				taken = c.or(taken, zr)
+			}
+			if instr&0x1 != 0 {
+				taken = c.or(taken, c.and(ng.not(), zr.not()))
+			}
+		}
+		if taken == litFalse {
+			s.pc++
+			continue
+		}
+		if taken == litTrue {
+			// a jump to itself, directly or through the A-instruction before it, is the end
+			if addr == s.pc || addr == s.pc-1 && k.prog.Code[addr] == uint16(addr) {
+				return nil, true, nil
+			}
+			s.pc = addr
+			continue
+		}
+
+		canJump := c.s.solve(append(s.path, pre, taken)...)
+		canFall := c.s.solve(append(s.path, pre, taken.not())...)
+		switch {
+		case canJump && canFall:
+			next = s.fork()
+			next.path = append(next.path, taken)
+			next.pc = addr
+			s.path = append(s.path, taken.not())
+			s.pc++
+			return next, false, nil
+		case canJump:
+			s.pc = addr
+		default:
+			s.pc++
+		}
+	}
+}
+
+// read returns RAM[addr] on the path
+func (k *checker) read(s *state, addr int) word {
+	if w, ok := s.ram[addr]; ok {
+		return w
+	}
+	return k.initial(addr)
+}
+
+// initial returns the unknown initial value of RAM[addr]
+func (k *checker) initial(addr int) word {
+	w, ok := k.init[addr]
+	if !ok {
+		w = k.c.fresh()
+		k.init[addr] = w
+	}
+	return w
+}
+
+// alu computes the Hack ALU's output for the control bits zx nx zy ny f no
+func (k *checker) alu(x, y word, bits uint16) word {
+	c := k.c
+	if bits&0x20 != 0 {
+		x = constant(0)
+	}
+	if bits&0x10 != 0 {
+		x = c.notWord(x)
+	}
+	if bits&0x08 != 0 {
+		y = constant(0)
+	}
+	if bits&0x04 != 0 {
+		y = c.notWord(y)
+	}
+	var out word
+	if bits&0x02 != 0 {
+		out = c.add(x, y, litFalse)
+	} else {
+		out = c.andWord(x, y)
+	}
+	if bits&0x01 != 0 {
+		out = c.notWord(out)
+	}
+	return out
+}
+
+// checkPost looks for initial values that lead down the halted path s and break a postcondition
+func (k *checker) checkPost(s *state, pre lit, posts []*node) *Counterexample {
+	c := k.c
+	lits := make([]lit, len(posts))
+	all := litTrue
+	for i, n := range posts {
+		lits[i] = k.eval(n, s).cond
+		all = c.and(all, lits[i])
+	}
+	if !c.s.solve(append(s.path, pre, all.not())...) {
+		return nil
+	}
+
+	cex := &Counterexample{Initial: []Value{}, Final: []Value{}, Failed: []string{}, Steps: s.steps, PC: s.pc}
+	for i, n := range posts {
+		if !c.s.modelValue(lits[i]) {
+			cex.Failed = append(cex.Failed, n.text)
+		}
+	}
+	if k.usedA {
+		cex.Initial = append(cex.Initial, Value{Name: "A", Addr: -1, Value: k.model(k.initA)})
+	}
+	if k.usedD {
+		cex.Initial = append(cex.Initial, Value{Name: "D", Addr: -1, Value: k.model(k.initD)})
+	}
+	for _, addr := range sortedAddrs(k.init) {
+		cex.Initial = append(cex.Initial, Value{Name: k.name(addr), Addr: addr, Value: k.model(k.init[addr])})
+	}
+	for _, addr := range sortedAddrs(s.ram) {
+		cex.Final = append(cex.Final, Value{Name: k.name(addr), Addr: addr, Value: k.model(s.ram[addr])})
+	}
+	return cex
+}
+
+// model returns the value of w in the solver's satisfying assignment
+func (k *checker) model(w word) int16 {
+	var v uint16
+	for i, b := range w {
+		if b == litTrue || b != litFalse && k.c.s.modelValue(b) {
+			v |= 1 << i
+		}
+	}
+	return int16(v)
+}
+
+// name gives a RAM address the name a reader knows it by: a variable, Rn or RAM[n]
+func (k *checker) name(addr int) string {
+	for _, sym := range k.prog.Symbols.Symbols() {
+		if sym.Kind == asm.Variable && sym.Address == addr {
+			return sym.Name
+		}
+	}
+	if addr < 16 {
+		return fmt.Sprintf("R%d", addr)
+	}
+	return fmt.Sprintf("RAM[%d]", addr)
+}
+
+func sortedAddrs(m map[int]word) []int {
+	addrs := make([]int, 0, len(m))
+	for addr := range m {
+		addrs = append(addrs, addr)
+	}
+	sort.Ints(addrs)
+	return addrs
+}
+
+// result of evaluating a node: a value or a condition
+type result struct {
+	val  word
+	cond lit
+}
+
+// eval builds the circuit for a condition or value in state s
+func (k *checker) eval(n *node, s *state) result {
+	c := k.c
+	switch n.op {
+	case "num":
+		return result{val: constant(n.num)}
+	case "ram":
+		if n.old {
+			return result{val: k.initial(n.addr)}
+		}
+		return result{val: k.read(s, n.addr)}
+	case "reg":
+		w := s.d
+		if n.reg == 'A' {
+			w = s.a
+		}
+		if n.old && n.reg == 'A' {
+			w = k.initA
+		} else if n.old {
+			w = k.initD
+		}
+		k.usedA = k.usedA || w == k.initA
+		k.usedD = k.usedD || w == k.initD
+		return result{val: w}
+	case "neg-":
+		return result{val: c.neg(k.eval(n.args[0], s).val)}
+	case "neg~":
+		return result{val: c.notWord(k.eval(n.args[0], s).val)}
+	case "!":
+		return result{cond: k.eval(n.args[0], s).cond.not()}
+	}
+
+	x, y := k.eval(n.args[0], s), k.eval(n.args[1], s)
+	switch n.op {
+	case "==>":
+		return result{cond: c.or(x.cond.not(), y.cond)}
+	case "||":
+		return result{cond: c.or(x.cond, y.cond)}
+	case "&&":
+		return result{cond: c.and(x.cond, y.cond)}
+	case "==":
+		return result{cond: c.eq(x.val, y.val)}
+	case "!=":
+		return result{cond: c.eq(x.val, y.val).not()}
+	case "<":
+		return result{cond: c.less(x.val, y.val)}
+	case ">":
+		return result{cond: c.less(y.val, x.val)}
+	case "<=":
+		return result{cond: c.less(y.val, x.val).not()}
+	case ">=":
+		return result{cond: c.less(x.val, y.val).not()}
+	case "+":
+		return result{val: c.add(x.val, y.val, litFalse)}
+	case "-":
+		return result{val: c.sub(x.val, y.val)}
+	case "*":
+		return result{val: c.mul(x.val, y.val)}
+	case "&":
+		return result{val: c.andWord(x.val, y.val)}
+	case "|":
+		return result{val: c.orWord(x.val, y.val)}
+	case "^":
+		return result{val: c.xorWord(x.val, y.val)}
+	}
+	panic("verify: unknown operator " + n.op)
+}
+
+// String describes the counterexample on several lines
+func (cex *Counterexample) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "fails %s\n", strings.Join(cex.Failed, ", "))
+	b.WriteString("initial values:")
+	for _, v := range cex.Initial {
+		fmt.Fprintf(&b, " %s=%d", v.Name, v.Value)
+	}
+	fmt.Fprintf(&b, "\nhalts at ROM[%d] after %d steps with:", cex.PC, cex.Steps)
+	for _, v := range cex.Final {
+		fmt.Fprintf(&b, " %s=%d", v.Name, v.Value)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
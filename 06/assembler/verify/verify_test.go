@@ -0,0 +1,208 @@
+package verify
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"hack-assembler/asm"
+)
+
+// load reads a program of project 04, where the course's routines live
+func load(t *testing.T, name string) string {
+	src, err := os.ReadFile(filepath.Join("..", "..", "..", "04", strings.ToLower(strings.TrimSuffix(name, ".asm")), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(src)
+}
+
+func check(t *testing.T, src string, spec Spec) *Result {
+	prog := asm.Assemble(strings.NewReader(src))
+	if len(prog.Diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", prog.Diags)
+	}
+	res, err := Check(prog, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// TestMult proves the course's multiplication routine correct for small factors
+func TestMult(t *testing.T) {
+	res := check(t, load(t, "Mult.asm"), Spec{
+		Pre:   []string{"R0 >= 0 && R1 >= 0", "R0 <= 6 || R1 <= 6"},
+		Post:  []string{"R2 == old(R0)*old(R1)"},
+		Bound: 200,
+	})
+	if res.Counterexample != nil {
+		t.Fatalf("unexpected counterexample:\n%s", res.Counterexample)
+	}
+	if res.Truncated != 0 || res.Paths == 0 || res.Vacuous {
+		t.Errorf("got %d paths, %d truncated, vacuous %v; want every path to halt", res.Paths, res.Truncated, res.Vacuous)
+	}
+}
+
+// TestMultBound checks Mult without limiting the factors, so long loops run past the bound
+func TestMultBound(t *testing.T) {
+	res := check(t, load(t, "Mult.asm"), Spec{
+		Pre:   []string{"R0 >= 0 && R1 >= 0"},
+		Post:  []string{"R2 == old(R0)*old(R1)"},
+		Bound: 60,
+	})
+	if res.Counterexample != nil {
+		t.Fatalf("unexpected counterexample:\n%s", res.Counterexample)
+	}
+	if res.Truncated == 0 {
+		t.Error("no paths truncated at the bound")
+	}
+}
+
+// TestMultBug finds the bug when R2 is not cleared first
+func TestMultBug(t *testing.T) {
+	src := strings.Replace(load(t, "Mult.asm"), "M=0", "", 1)
+	res := check(t, src, Spec{
+		Pre:   []string{"R0 >= 0 && R1 >= 0", "R1 <= 3"},
+		Post:  []string{"R2 == old(R0)*old(R1)"},
+		Bound: 200,
+	})
+	cex := res.Counterexample
+	if cex == nil {
+		t.Fatal("no counterexample")
+	}
+	values := map[string]int16{}
+	for _, v := range cex.Initial {
+		values[v.Name] = v.Value
+	}
+	for _, v := range cex.Final {
+		if v.Name == "R2" && v.Value == values["R0"]*values["R1"] {
+			t.Errorf("R2 = %d is the right answer for R0=%d R1=%d", v.Value, values["R0"], values["R1"])
+		}
+	}
+	if values["R2"] == 0 {
+		t.Errorf("counterexample starts with R2 = 0:\n%s", cex)
+	}
+	if len(cex.Failed) != 1 || !strings.Contains(cex.String(), "initial values:") {
+		t.Errorf("bad report:\n%s", cex)
+	}
+}
+
+func TestSpecs(t *testing.T) {
+	tests := []struct {
+		src   string
+		pre   []string
+		post  []string
+		holds bool
+	}{
+		{"@R0\nD=M\n@R1\nM=D", nil, []string{"R1 == old(R0)"}, true},
+		{"@R0\nD=M\n@R1\nM=D", nil, []string{"R1 == R0 && D == R0"}, true},
+		{"@R0\nD=M\n@R1\nM=D+1", nil, []string{"R1 > R0"}, false},
+		{"@R0\nD=M\n@R1\nM=D+1", []string{"R0 < 32767"}, []string{"R1 > R0"}, true},
+		{"@R0\nM=-M", nil, []string{"R0 == -old(R0)", "R0 + old(R0) == 0"}, true},
+		{"@R0\nD=M\n@POS\nD;JGE\n@R0\nM=-D\n(POS)", []string{"R0 != -32768"}, []string{"R0 >= 0"}, true},
+		{"@R0\nD=M\n@POS\nD;JGE\n@R0\nM=-D\n(POS)", nil, []string{"R0 >= 0"}, false},
+		{"@x\nM=1", nil, []string{"x == 1 && RAM[16] == 1"}, true},
+		{"@R0\nD=M\n@R1\nM=D&A\n", nil, []string{"R1 == (R0 & 1)", "R1 ^ R1 == 0", "~R1 | R1 == -1"}, true},
+		{"@R0\nM=0\n(END)\n@END\n0;JMP", nil, []string{"R0 == 0"}, true},
+		{"@R0\nD=M\n@R1\nM=D", []string{"R0 > 0 ==> R0 < 10"}, []string{"R1 < 10"}, true},
+		{"@R0\nD=M\n@R1\nM=D", []string{"R0 > 0 ==> R0 < 10"}, []string{"R1 < 9"}, false},
+	}
+	for _, test := range tests {
+		res := check(t, test.src, Spec{Pre: test.pre, Post: test.post, Bound: 100})
+		if got := res.Counterexample == nil; got != test.holds {
+			t.Errorf("%q with pre %q post %q: holds = %v, want %v", test.src, test.pre, test.post, got, test.holds)
+		}
+	}
+}
+
+func TestSpecErrors(t *testing.T) {
+	prog := asm.Assemble(strings.NewReader("(LOOP)\n@x\nM=1\n@LOOP\n0;JMP"))
+	for _, spec := range []Spec{
+		{Post: []string{"R0"}, Bound: 10},
+		{Post: []string{"LOOP == 1"}, Bound: 10},
+		{Post: []string{"nope == 1"}, Bound: 10},
+		{Post: []string{"R0 == (1"}, Bound: 10},
+		{Post: []string{"R0 && R1"}, Bound: 10},
+		{Post: []string{"RAM[40000] == 0"}, Bound: 10},
+		{Pre: []string{"old(R0) == 0"}, Bound: 10},
+		{Post: []string{"f(R0) == 0"}, Bound: 10},
+		{Post: []string{"!R0 == 0"}, Bound: 10},
+		{Post: []string{"R0 == 0"}, Bound: 0},
+		{Post: []string{"R0 == 0"}, Bound: 10, Halt: "x"},
+	} {
+		if _, err := Check(prog, spec); err == nil {
+			t.Errorf("%+v: no error", spec)
+		}
+	}
+
+	res, err := Check(prog, Spec{Pre: []string{"R0 == 1", "R0 == 2"}, Bound: 10})
+	if err != nil || !res.Vacuous {
+		t.Errorf("contradictory preconditions: got %+v, %v; want vacuous", res, err)
+	}
+	res, err = Check(prog, Spec{Post: []string{"x == 1"}, Bound: 10, Halt: "LOOP"})
+	if err != nil || res.Counterexample != nil || res.Paths != 1 {
+		t.Errorf("halt label: got %+v, %v", res, err)
+	}
+
+	pointer := asm.Assemble(strings.NewReader("@R0\nA=M\nM=0"))
+	if _, err := Check(pointer, Spec{Bound: 10}); err == nil || !strings.Contains(err.Error(), "ROM[2]") {
+		t.Errorf("computed address: got %v", err)
+	}
+}
+
+// TestSolver compares the SAT solver with brute force on random 3-SAT problems near the threshold
+func TestSolver(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		n := 4 + rng.Intn(9)
+		clauses := make([][]lit, int(4.3*float64(n)))
+		s := newSolver()
+		vars := make([]lit, n)
+		for i := range vars {
+			vars[i] = s.newVar()
+		}
+		for i := range clauses {
+			for j := 0; j < 3; j++ {
+				l := vars[rng.Intn(n)]
+				if rng.Intn(2) == 0 {
+					l = l.not()
+				}
+				clauses[i] = append(clauses[i], l)
+			}
+			s.addClause(clauses[i]...)
+		}
+
+		satisfies := func(value func(lit) bool) bool {
+			for _, c := range clauses {
+				ok := false
+				for _, l := range c {
+					ok = ok || value(l)
+				}
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		want := false
+		for bits := 0; bits < 1<<n && !want; bits++ {
+			want = satisfies(func(l lit) bool {
+				i := 0
+				for vars[i].v() != l.v() {
+					i++
+				}
+				return (bits>>i&1 == 1) != l.neg()
+			})
+		}
+		got := s.solve()
+		if got != want {
+			t.Fatalf("round %d: solve = %v, want %v", round, got, want)
+		}
+		if got && !satisfies(s.modelValue) {
+			t.Fatalf("round %d: model does not satisfy the clauses", round)
+		}
+	}
+}
@@ -1,3 +1,15 @@
 module vmTranslator
 
 go 1.20
+
+require (
+	diagnostic v0.0.0
+	hack-assembler v0.0.0
+	vmcheck v0.0.0
+)
+
+replace (
+	diagnostic => ../diagnostic
+	hack-assembler => ../06/assembler
+	vmcheck => ../vmcheck
+)
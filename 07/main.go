@@ -2,48 +2,74 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
+
+	"diagnostic"
+	"vmcheck"
 )
 
 func main() {
-	filename := os.Args[1]
+	format := flag.String("format", diagnostic.FormatText, "diagnostic output format: text or json")
+	flag.Parse()
+	if flag.NArg() != 1 || !diagnostic.ValidFormat(*format) {
+		fmt.Fprintln(os.Stderr, "usage: vmTranslator [--format=text|json] file.vm")
+		os.Exit(2)
+	}
+	filename := flag.Arg(0)
+	os.Exit(vmcheck.Report(*format, translate(filename, *format == diagnostic.FormatText)))
+}
 
+// translate writes the assembly for filename next to it, returning the problems found
+func translate(filename string, verbose bool) []diagnostic.Diagnostic {
 	// input file
 	file, err := os.Open(filename)
 	if err != nil {
-		fmt.Println("Error reading filename: ", err)
-		return
+		d := diagnostic.Errorf(0, 0, "open-failed", "error reading file: %v", err)
+		d.File = filename
+		return []diagnostic.Diagnostic{d}
 	}
 	defer file.Close()
 
 	// output file
 	outFile := strings.TrimSuffix(filename, "vm") + "asm"
-	ofile, err := os.OpenFile(outFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	ofile, err := vmcheck.CreateOutput(outFile)
 	if err != nil {
-		log.Println(err)
+		d := diagnostic.Errorf(0, 0, "write-failed", "error creating output: %v", err)
+		d.File = outFile
+		return []diagnostic.Diagnostic{d}
 	}
-	defer ofile.Close()
+	defer ofile.Discard()
 
-	fmt.Printf("Translating %s\n", filename)
-	fmt.Printf("Assembly code at %s\n", outFile)
+	if verbose {
+		fmt.Printf("Translating %s\n", filename)
+		fmt.Printf("Assembly code at %s\n", outFile)
+	}
 
 	// static name
 	trimName := strings.Split(filename, "/")
 	staticName := "@" + strings.TrimSuffix(trimName[len(trimName)-1], "vm")
 
 	p := NewParser(file)
-	w := NewCodeWriter(ofile, staticName)
+	w := NewCodeWriter(ofile.File, staticName)
 
 	pass := bufio.NewScanner(p.stream)
 	for pass.Scan() {
+		p.line++
 		line := pass.Text()
-		if len(line) > 0 && line != "\n" && line[0:2] != "//" {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) != "" {
 			p.current = line
-			w.writeComment(p.current)
+			if problems := vmcheck.Check(p.command(), numArgs); len(problems) > 0 {
+				p.diags = append(p.diags, problems...)
+				continue
+			}
+			w.writeComment(strings.TrimSpace(p.current))
 			cmdType := p.commandType()
 			switch {
 			case cmdType == "C_ARITHMETIC":
@@ -52,30 +78,51 @@ func main() {
 			case cmdType == "C_PUSH" || cmdType == "C_POP":
 				segment := p.arg1()
 				index := p.arg2()
-				if err != nil {
-					log.Println(err)
-				}
 				w.writePushPop(cmdType, segment, index)
 			}
 		}
 	}
 	w.writeInfiniteLoop()
+
+	diags := p.diags
+	if err := pass.Err(); err != nil {
+		diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: filename, Code: "read-failed", Message: err.Error()})
+	}
+	if w.err != nil {
+		diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: outFile, Code: "write-failed", Message: w.err.Error()})
+	}
+	// the output goes in place only when nothing is wrong with it
+	if !diagnostic.HasErrors(diags) {
+		if err := ofile.Commit(); err != nil {
+			diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: outFile, Code: "write-failed", Message: err.Error()})
+		}
+	}
+	return diags
 }
 
 // Parser holds the input stream for parsing and current command
 type Parser struct {
 	stream  *os.File
 	current string
+	file    string
+	line    int
+	diags   []diagnostic.Diagnostic
 }
 
 // NewParser creates a new Parser
 func NewParser(s *os.File) *Parser {
 	p := &Parser{
 		stream: s,
+		file:   s.Name(),
 	}
 	return p
 }
 
+// command returns the current command for vmcheck
+func (p *Parser) command() vmcheck.Command {
+	return vmcheck.Command{File: p.file, Line: p.line, Text: p.current}
+}
+
 // returns a constant representing the type of the current comand. If current command is arithmetic-logical command, returns C_ARITHMETIC
 func (p *Parser) commandType() string {
 	commands := strings.Fields(p.current)
@@ -105,16 +152,29 @@ func (p *Parser) arg1() string {
 // returns second argument of the current command. Should only be called if current command is C_PUSH, C_POP, C_FUNCTION, or C_CALL.
 func (p *Parser) arg2() int {
 	args := strings.Fields(p.current)
-	a2, err := strconv.Atoi(args[2])
-	if err != nil {
-		log.Println(err)
-	}
+	a2, _ := strconv.Atoi(args[2]) // vmcheck.Check has made sure it is a number
 	return a2
 }
 
+// number of arguments each command takes
+var numArgs = map[string]int{
+	"add":  0,
+	"sub":  0,
+	"neg":  0,
+	"eq":   0,
+	"gt":   0,
+	"lt":   0,
+	"and":  0,
+	"or":   0,
+	"not":  0,
+	"push": 2,
+	"pop":  2,
+}
+
 // CodeWriter holds the generated code output stream
 type CodeWriter struct {
 	stream     *os.File
+	err        error // first error writing to stream
 	staticName string
 	jumpCount  int
 }
@@ -147,9 +207,7 @@ func (c *CodeWriter) writeArithmetic(s string) {
 		asm += popD() + "\tD=!D\n"
 	}
 	asm += pushD() + incrementSP()
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write to the output file the assembly code that implemetns the given push or pop command
@@ -168,7 +226,7 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 	if command == "C_PUSH" {
 		switch {
 		case segment == "argument" || segment == "local" || segment == "this" || segment == "that":
-			asm += constD(indexString) + "\t@" + short[segment] + "\n\tA=M+D\n\tD=M\n"
+			asm += constD(indexString) + "\t@" + short[segment] + "\n\tA=D+M\n\tD=M\n"
 
 		case segment == "constant":
 			asm += constD(indexString)
@@ -190,7 +248,7 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 	if command == "C_POP" {
 		switch {
 		case segment == "argument" || segment == "local" || segment == "this" || segment == "that":
-			asm += constD(indexString) + "\t@" + short[segment] + "\n\tD=M+D\n\t@R13\n\tM=D\n" +
+			asm += constD(indexString) + "\t@" + short[segment] + "\n\tD=D+M\n\t@R13\n\tM=D\n" +
 				popD() + "\t@R13\n\tA=M\n\tM=D\n"
 
 		case segment == "pointer" && indexString == "0":
@@ -204,25 +262,27 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 			asm += popD() + "\t@" + strconv.Itoa(index+5) + "\n\tM=D\n"
 		}
 	}
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write infinite loop at the end of the asm file
 func (c *CodeWriter) writeInfiniteLoop() {
 	asm := "// end of program\n(INFINITE_LOOP)\n\t@INFINITE_LOOP\n\t0;JMP"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
+	c.write(asm)
+}
+
+// write adds asm to the output file, keeping the first error
+func (c *CodeWriter) write(asm string) {
+	if c.err != nil {
+		return
 	}
+	_, c.err = c.stream.WriteString(asm)
 }
 
 // writes vm line as comment
 func (c *CodeWriter) writeComment(s string) {
 	s = "// " + s + "\n"
-	if _, err := c.stream.WriteString(s); err != nil {
-		log.Println(err)
-	}
+	c.write(s)
 }
 
 // increment stack pointer
@@ -260,7 +320,7 @@ func pop2(s string) string {
 		"\t@SP\n\tM=M-1\n\tA=M\n"
 	switch {
 	case s == "add":
-		phrase += "\tD=M+D\n"
+		phrase += "\tD=D+M\n"
 	case s == "sub":
 		phrase += "\tD=M-D\n"
 	case s == "and":
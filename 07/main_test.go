@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"diagnostic"
+	"hack-assembler/asm"
+)
+
+// translateSource writes src to name.vm in a temporary directory, translates it and returns
+// the diagnostics and the assembly written, which after an error is none
+func translateSource(t *testing.T, name, src string) ([]diagnostic.Diagnostic, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name+".vm")
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	diags := translate(path, false)
+	out, err := os.ReadFile(strings.TrimSuffix(path, "vm") + "asm")
+	if diagnostic.HasErrors(diags) {
+		if err == nil {
+			t.Errorf("%s.asm written despite %v", name, diags)
+		}
+		return diags, ""
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return diags, string(out)
+}
+
+// TestAssembles checks that the output of every command is accepted by the assembler
+func TestAssembles(t *testing.T) {
+	src := `push constant 7
+push constant 8
+add
+push constant 3
+sub
+neg
+push constant 1
+eq
+push constant 2
+gt
+push constant 3
+lt
+and
+push constant 4
+or
+not
+pop local 0
+push local 0
+pop argument 1
+push argument 1
+pop this 2
+push this 2
+pop that 3
+push that 3
+pop pointer 0
+push pointer 1
+pop temp 6
+push temp 6
+pop static 4
+push static 4
+`
+	diags, out := translateSource(t, "All", src)
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	prog := asm.Assemble(strings.NewReader(out))
+	if len(prog.Diags) > 0 {
+		t.Fatalf("assembler diagnostics: %v", prog.Diags)
+	}
+	if len(prog.Code) == 0 {
+		t.Fatal("no code assembled")
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		line string
+		code string
+		col  int
+	}{
+		{"push that t", "invalid-index", 11},
+		{"push pointer p", "invalid-index", 14},
+		{"  pop   constant 2", "pop-constant", 9},
+		{"push\tlocal x", "invalid-index", 12},
+		{"  fetch 1", "unknown-command", 3},
+		{"push pointer 2", "index-out-of-range", 14},
+	}
+	for _, tt := range tests {
+		diags, _ := translateSource(t, "Cols", tt.line+"\n")
+		if len(diags) != 1 || diags[0].Code != tt.code || diags[0].Column != tt.col {
+			t.Errorf("%q: got %v, want %s at column %d", tt.line, diags, tt.code, tt.col)
+		}
+	}
+}
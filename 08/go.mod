@@ -1,3 +1,17 @@
 module vmTranslator
 
 go 1.20
+
+require (
+	diagnostic v0.0.0
+	hack-assembler v0.0.0
+	hack-emulator v0.0.0
+	vmcheck v0.0.0
+)
+
+replace (
+	diagnostic => ../diagnostic
+	hack-assembler => ../06/assembler
+	hack-emulator => ../05/emulator
+	vmcheck => ../vmcheck
+)
@@ -2,15 +2,29 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
+
+	"diagnostic"
+	"vmcheck"
 )
 
 func main() {
-	filename := os.Args[1]
+	format := flag.String("format", diagnostic.FormatText, "diagnostic output format: text or json")
+	flag.Parse()
+	if flag.NArg() != 1 || !diagnostic.ValidFormat(*format) {
+		fmt.Fprintln(os.Stderr, "usage: vmTranslator [--format=text|json] file.vm|directory")
+		os.Exit(2)
+	}
+	filename := flag.Arg(0)
+	os.Exit(vmcheck.Report(*format, translate(filename, *format == diagnostic.FormatText)))
+}
+
+// translate writes the assembly for a .vm file or a directory of them, returning the problems found
+func translate(filename string, verbose bool) []diagnostic.Diagnostic {
 	trimName := strings.Split(strings.TrimSuffix(filename, "/"), "/")
 	staticName := "@" + trimName[len(trimName)-1]
 	outFile := ""
@@ -25,37 +39,62 @@ func main() {
 		outFile = filename + "/" + trimName[len(trimName)-1] + ".asm"
 		dirFiles, err := os.ReadDir(filename)
 		if err != nil {
-			log.Fatal(err)
+			d := diagnostic.Errorf(0, 0, "open-failed", "error reading directory: %v", err)
+			d.File = filename
+			return []diagnostic.Diagnostic{d}
 		}
 		for _, f := range dirFiles {
 			if strings.HasSuffix(f.Name(), ".vm") {
 				files = append(files, filename+"/"+f.Name())
 			}
 		}
+		if len(files) == 0 {
+			d := diagnostic.Errorf(0, 0, "no-input", "no .vm files found")
+			d.File = filename
+			return []diagnostic.Diagnostic{d}
+		}
 		staticName += "."
 	}
 
-	fmt.Printf("Translating %s\n", filename)
-	fmt.Printf("Assembly code at %s\n", outFile)
+	if verbose {
+		fmt.Printf("Translating %s\n", filename)
+		fmt.Printf("Assembly code at %s\n", outFile)
+	}
 
 	// output file
-	ofile, err := os.OpenFile(outFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	ofile, err := vmcheck.CreateOutput(outFile)
 	if err != nil {
-		log.Println(err)
+		d := diagnostic.Errorf(0, 0, "write-failed", "error creating output: %v", err)
+		d.File = outFile
+		return []diagnostic.Diagnostic{d}
 	}
-	defer ofile.Close()
+	defer ofile.Discard()
 
-	fmt.Printf("Static name %s\n", staticName)
+	if verbose {
+		fmt.Printf("Static name %s\n", staticName)
+	}
 
-	w := NewCodeWriter(ofile, staticName)
+	w := NewCodeWriter(ofile.File, staticName)
 	w.bootstrap()
 
+	diags := []diagnostic.Diagnostic{}
+	// functions defined in any file, and calls to check against them once every file is read
+	defined := map[string]bool{}
+	calls := []diagnostic.Diagnostic{
+		diagnostic.Warningf(0, 0, "undefined-function", "Sys.init is not defined, but the bootstrap code calls it").
+			WithFix("translate a directory that includes Sys.vm"),
+	}
+	calls[0].File = filename
+	callees := []string{"Sys.init"}
+
 	for _, f := range files {
 		// input file
 		file, err := os.Open(f)
 		if err != nil {
-			fmt.Println("Error reading filename: ", err)
-			return
+			d := diagnostic.Errorf(0, 0, "open-failed", "error reading file: %v", err)
+			d.File = f
+			diags = append(diags, d)
+			continue
 		}
 		defer file.Close()
 
@@ -65,10 +104,18 @@ func main() {
 
 		pass := bufio.NewScanner(p.stream)
 		for pass.Scan() {
+			p.line++
 			line := pass.Text()
-			if len(line) > 0 && line != "\n" && line[0:2] != "//" {
+			if i := strings.Index(line, "//"); i >= 0 {
+				line = line[:i]
+			}
+			if strings.TrimSpace(line) != "" {
 				p.current = line
-				w.writeComment(p.current)
+				if problems := vmcheck.Check(p.command(), numArgs); len(problems) > 0 {
+					p.diags = append(p.diags, problems...)
+					continue
+				}
+				w.writeComment(strings.TrimSpace(p.current))
 				cmdType := p.commandType()
 				switch {
 				case cmdType == "C_ARITHMETIC":
@@ -77,9 +124,6 @@ func main() {
 				case cmdType == "C_PUSH" || cmdType == "C_POP":
 					segment := p.arg1()
 					index := p.arg2()
-					if err != nil {
-						log.Println(err)
-					}
 					w.writePushPop(cmdType, segment, index)
 				case cmdType == "C_LABEL":
 					label := p.arg1()
@@ -94,32 +138,68 @@ func main() {
 					fnName := p.arg1()
 					nVars := p.arg2()
 					w.writeFunction(fnName, nVars)
+					defined[fnName] = true
 				case cmdType == "C_CALL":
 					fnName := p.arg1()
 					nArgs := p.arg2()
 					w.writeCall(fnName, nArgs)
+					d := diagnostic.Warningf(p.line, p.command().Col(1), "undefined-function", "call to %s, which is not defined in any translated file", fnName).
+						WithFix("add the .vm file that defines %s to the directory", fnName)
+					d.File = p.file
+					calls = append(calls, d)
+					callees = append(callees, fnName)
 				case cmdType == "C_RETURN":
 					w.writeReturn()
 				}
 			}
 		}
+		diags = append(diags, p.diags...)
+		if err := pass.Err(); err != nil {
+			diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: f, Code: "read-failed", Message: err.Error()})
+		}
+	}
+
+	for i, fnName := range callees {
+		if !defined[fnName] {
+			diags = append(diags, calls[i])
+		}
+	}
+	if w.err != nil {
+		diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: outFile, Code: "write-failed", Message: w.err.Error()})
+	}
+	// the output goes in place only when nothing is wrong with it
+	if !diagnostic.HasErrors(diags) {
+		if err := ofile.Commit(); err != nil {
+			diags = append(diags, diagnostic.Diagnostic{Severity: diagnostic.Error, File: outFile, Code: "write-failed", Message: err.Error()})
+		}
 	}
+	diagnostic.Sort(diags)
+	return diags
 }
 
 // Parser holds the input stream for parsing and current command
 type Parser struct {
 	stream  *os.File
 	current string
+	file    string
+	line    int
+	diags   []diagnostic.Diagnostic
 }
 
 // NewParser creates a new Parser
 func NewParser(s *os.File) *Parser {
 	p := &Parser{
 		stream: s,
+		file:   s.Name(),
 	}
 	return p
 }
 
+// command returns the current command for vmcheck
+func (p *Parser) command() vmcheck.Command {
+	return vmcheck.Command{File: p.file, Line: p.line, Text: p.current}
+}
+
 // returns a constant representing the type of the current comand. If current command is arithmetic-logical command, returns C_ARITHMETIC
 func (p *Parser) commandType() string {
 	commands := strings.Fields(p.current)
@@ -161,16 +241,35 @@ func (p *Parser) arg1() string {
 // returns second argument of the current command. Should only be called if current command is C_PUSH, C_POP, C_FUNCTION, or C_CALL.
 func (p *Parser) arg2() int {
 	args := strings.Fields(p.current)
-	a2, err := strconv.Atoi(args[2])
-	if err != nil {
-		log.Println(err)
-	}
+	a2, _ := strconv.Atoi(args[2]) // vmcheck.Check has made sure it is a number
 	return a2
 }
 
+// number of arguments each command takes
+var numArgs = map[string]int{
+	"add":      0,
+	"sub":      0,
+	"neg":      0,
+	"eq":       0,
+	"gt":       0,
+	"lt":       0,
+	"and":      0,
+	"or":       0,
+	"not":      0,
+	"push":     2,
+	"pop":      2,
+	"label":    1,
+	"goto":     1,
+	"if-goto":  1,
+	"function": 2,
+	"call":     2,
+	"return":   0,
+}
+
 // CodeWriter holds the generated code output stream
 type CodeWriter struct {
 	stream     *os.File
+	err        error // first error writing to stream
 	staticName string
 	jumpCount  int
 	retCount   int
@@ -204,9 +303,7 @@ func (c *CodeWriter) writeArithmetic(s string) {
 		asm += popD() + "\tD=!D\n"
 	}
 	asm += pushD() + incrementSP()
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write to the output file the assembly code that implemetns the given push or pop command
@@ -225,7 +322,7 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 	if command == "C_PUSH" {
 		switch {
 		case segment == "argument" || segment == "local" || segment == "this" || segment == "that":
-			asm += constD(indexString) + "\t@" + short[segment] + "\n\tA=M+D\n\tD=M\n"
+			asm += constD(indexString) + "\t@" + short[segment] + "\n\tA=D+M\n\tD=M\n"
 
 		case segment == "constant":
 			asm += constD(indexString)
@@ -247,7 +344,7 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 	if command == "C_POP" {
 		switch {
 		case segment == "argument" || segment == "local" || segment == "this" || segment == "that":
-			asm += constD(indexString) + "\t@" + short[segment] + "\n\tD=M+D\n\t@R13\n\tM=D\n" +
+			asm += constD(indexString) + "\t@" + short[segment] + "\n\tD=D+M\n\t@R13\n\tM=D\n" +
 				popD() + "\t@R13\n\tA=M\n\tM=D\n"
 
 		case segment == "pointer" && indexString == "0":
@@ -261,33 +358,25 @@ func (c *CodeWriter) writePushPop(command string, segment string, index int) {
 			asm += popD() + "\t@" + strconv.Itoa(index+5) + "\n\tM=D\n"
 		}
 	}
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write a section (LABEL) in assembly
 func (c *CodeWriter) writeLabel(s string) {
 	asm := "(" + s + ")\n"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write an unconditional jump in assembly
 func (c *CodeWriter) writeGoto(s string) {
 	asm := "\t@" + s + "\n\t0;JMP\n"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // write a conditional jump in assembly, based on results in stack
 func (c *CodeWriter) writeIf(s string) {
 	asm := popD() + "\t@" + s + "\n\tD;JNE\n"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // writeFunction initializes the local variables of the callee
@@ -296,9 +385,7 @@ func (c *CodeWriter) writeFunction(fnName string, nVars int) {
 	for i := 0; i < nVars; i++ {
 		asm += constD("0") + pushD() + incrementSP()
 	}
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // writeCall saves the frame of the caller (on the satck) and jumps to execute the called function
@@ -321,9 +408,7 @@ func (c *CodeWriter) writeCall(fnName string, nArgs int) {
 	asm += "(" + fnName + "$ret" + strconv.Itoa(c.retCount) + ")\n"
 
 	c.retCount++
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // writeReturn copies the return value to the top of the caller's working stack, reinstates the segment pointers of the caller, and jumps to the returnAddress in the caller
@@ -347,34 +432,34 @@ func (c *CodeWriter) writeReturn() {
 	asm += constD("4") + "\t@frame\n\tD=M-D\n\tA=D\n\tD=M\n\t@LCL\n\tM=D\n"
 	// goto retAddr
 	asm += "\t@retAddr\n\tA=M\n\t0;JMP\n"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 }
 
 // bootstrap the file
 func (c *CodeWriter) bootstrap() {
 	asm := "// initialize program state\n(bootstrap)\n" + constD("256") + "\t@SP\n\tM=D\n" // +
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
-	}
+	c.write(asm)
 	c.writeCall("Sys.init", 0)
 }
 
 // write infinite loop at the end of the asm file
 func (c *CodeWriter) writeInfiniteLoop() {
 	asm := "// end of program\n(INFINITE_LOOP)\n\t@INFINITE_LOOP\n\t0;JMP"
-	if _, err := c.stream.WriteString(asm); err != nil {
-		log.Println(err)
+	c.write(asm)
+}
+
+// write adds asm to the output file, keeping the first error
+func (c *CodeWriter) write(asm string) {
+	if c.err != nil {
+		return
 	}
+	_, c.err = c.stream.WriteString(asm)
 }
 
 // writes vm line as comment
 func (c *CodeWriter) writeComment(s string) {
 	s = "// " + s + "\n"
-	if _, err := c.stream.WriteString(s); err != nil {
-		log.Println(err)
-	}
+	c.write(s)
 }
 
 // increment stack pointer
@@ -411,7 +496,7 @@ func pop2(s string) string {
 	phrase := popD() + decrementSP() + "\tA=M\n"
 	switch {
 	case s == "add":
-		phrase += "\tD=M+D\n"
+		phrase += "\tD=D+M\n"
 	case s == "sub":
 		phrase += "\tD=M-D\n"
 	case s == "and":
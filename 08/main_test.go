@@ -0,0 +1,256 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"diagnostic"
+	"hack-assembler/asm"
+	"hack-emulator/emu"
+)
+
+// writeVM writes the .vm files given by name into dir
+func writeVM(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name+".vm"), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// assembleOutput assembles the translator's output at path, failing on any diagnostic
+func assembleOutput(t *testing.T, path string) *asm.Program {
+	t.Helper()
+	src, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prog := asm.Assemble(strings.NewReader(string(src)))
+	if len(prog.Diags) > 0 {
+		t.Fatalf("assembler diagnostics: %v", prog.Diags)
+	}
+	return prog
+}
+
+const mainVM = `function Main.main 2
+push constant 7
+pop local 0
+push local 0
+push constant 8
+call Main.add 2
+pop local 1
+label LOOP
+push local 1
+push constant 1
+sub
+pop local 1
+push local 1
+if-goto LOOP
+goto END
+label END
+push local 0
+return
+function Main.add 0
+push argument 0
+push argument 1
+add
+neg
+not
+push constant 3
+and
+push constant 4
+or
+push constant 1
+eq
+push constant 2
+gt
+push constant 3
+lt
+pop temp 0
+push argument 0
+push argument 1
+add
+pop static 0
+push static 0
+pop pointer 0
+push pointer 0
+pop this 0
+push this 0
+pop pointer 1
+push pointer 1
+pop that 0
+push that 0
+return
+`
+
+const sysVM = `function Sys.init 0
+call Main.main 0
+pop temp 0
+label HALT
+goto HALT
+`
+
+// TestAssembles checks that the output for a directory and for a single file is accepted by
+// the assembler
+func TestAssembles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Prog")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeVM(t, dir, map[string]string{"Main": mainVM, "Sys": sysVM})
+	if diags := translate(dir, false); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	prog := assembleOutput(t, filepath.Join(dir, "Prog.asm"))
+	for _, label := range []string{"Sys.init", "Main.main", "Main.add", "LOOP"} {
+		if !prog.Symbols.Contains(label) {
+			t.Errorf("label %s missing from the output", label)
+		}
+	}
+
+	// a file on its own still has the bootstrap code, which calls the missing Sys.init
+	diags := translate(filepath.Join(dir, "Main.vm"), false)
+	if len(diags) != 1 || diags[0].Code != "undefined-function" || diags[0].Severity != diagnostic.Warning {
+		t.Errorf("Main.vm alone: got %v, want an undefined-function warning for Sys.init", diags)
+	}
+	assembleOutput(t, filepath.Join(dir, "Main.asm"))
+}
+
+// TestNoOutputOnError checks that a failed translation leaves the earlier output as it was,
+// and no partial one
+func TestNoOutputOnError(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Bad")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeVM(t, dir, map[string]string{"Main": mainVM + "push nowhere 1\n", "Sys": sysVM})
+	out := filepath.Join(dir, "Bad.asm")
+	if err := os.WriteFile(out, []byte("// before\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if diags := translate(dir, false); !diagnostic.HasErrors(diags) {
+		t.Fatalf("got %v, want an error", diags)
+	}
+	if got, err := os.ReadFile(out); err != nil || string(got) != "// before\n" {
+		t.Errorf("after the error Bad.asm holds %q (%v), want it as it was", got, err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, ".Bad.asm*")); len(files) > 0 {
+		t.Errorf("temporary files left behind: %v", files)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		line string
+		code string
+		col  int
+	}{
+		{"push that t", "invalid-index", 11},
+		{"push pointer p", "invalid-index", 14},
+		{"  pop   constant 2", "pop-constant", 9},
+		{"function f f", "invalid-count", 12},
+		{"call Main.f 1", "undefined-function", 6},
+		{"  label 1a", "invalid-name", 9},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "Cols.vm")
+		if err := os.WriteFile(path, []byte(tt.line+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		// the first diagnostic is the bootstrap code's call to the missing Sys.init
+		diags := translate(path, false)[1:]
+		if len(diags) != 1 || diags[0].Code != tt.code || diags[0].Column != tt.col {
+			t.Errorf("%q: got %v, want %s at column %d", tt.line, diags, tt.code, tt.col)
+		}
+	}
+}
+
+// TestRun translates the emulator's ProgVM test program, checks that the emulator's Prog.asm
+// is the translator's output as it is, then assembles it and runs it to its halt loop with
+// the results of ProgVM/Prog.cmp
+func TestRun(t *testing.T) {
+	testdata := filepath.Join("..", "05", "emulator", "emu", "testdata")
+	dir := filepath.Join(t.TempDir(), "Prog")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Main", "Sys"} {
+		src, err := os.ReadFile(filepath.Join(testdata, "ProgVM", name+".vm"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeVM(t, dir, map[string]string{name: string(src)})
+	}
+	if diags := translate(dir, false); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "Prog.asm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join(testdata, "Prog.asm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s differs from the translator's output; translate ProgVM again to update it", filepath.Join(testdata, "Prog.asm"))
+	}
+
+	prog := assembleOutput(t, filepath.Join(dir, "Prog.asm"))
+	m := emu.New()
+	if err := m.Load(prog.Code); err != nil {
+		t.Fatal(err)
+	}
+	if !m.RunToHalt(10000, emu.Halts{Loops: true}) {
+		t.Fatalf("Prog did not halt in 10000 cycles")
+	}
+	// the loop is @HALT and 0;JMP, and the halt is found at either
+	if halt := prog.Symbols.GetAddress("HALT"); int(m.PC) != halt && int(m.PC) != halt+1 {
+		t.Errorf("halted at PC %d, want the HALT loop at %d", m.PC, halt)
+	}
+	for addr, want := range map[int]uint16{0: 261, 5: 0, 262: 261, 263: 256} {
+		if m.RAM[addr] != want {
+			t.Errorf("RAM[%d] = %d, want %d", addr, m.RAM[addr], want)
+		}
+	}
+}
+
+// TestBig checks that the assembler's Big fixture, which stands in for the course's Pong,
+// is the translator's output for testdata/Big as it is
+func TestBig(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Big")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join("testdata", "Big", "*.vm"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no .vm files in testdata/Big: %v", err)
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeVM(t, dir, map[string]string{strings.TrimSuffix(filepath.Base(file), ".vm"): string(src)})
+	}
+	if diags := translate(dir, false); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	fixture := filepath.Join("..", "06", "assembler", "asm", "testdata", "Big.asm")
+	got, err := os.ReadFile(filepath.Join(dir, "Big.asm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s differs from the translator's output; translate testdata/Big again to update it", fixture)
+	}
+}
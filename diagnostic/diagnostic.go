@@ -0,0 +1,212 @@
+// Package diagnostic is the problem report shared by the assembler and the VM translators.
+// Diagnostics print as "file:line:column: severity[code]: message", or as JSON for CI and editors.
+package diagnostic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Severity ranks how serious a Diagnostic is
+type Severity int
+
+const (
+	// Info is a note that needs no action
+	Info Severity = iota
+	// Warning is a likely mistake that still produced output
+	Warning
+	// Error is a problem that makes the output wrong or incomplete
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return "unknown"
+}
+
+// MarshalText writes the severity as its name in JSON
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText reads a severity name
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "info":
+		*s = Info
+	case "warning":
+		*s = Warning
+	case "error":
+		*s = Error
+	default:
+		return fmt.Errorf("unknown severity %q", text)
+	}
+	return nil
+}
+
+// Diagnostic is one problem found in a source file.
+// Line and Column start at 1; 0 means the location is unknown.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+	Fix      string   `json:"fix,omitempty"` // suggested fix, if there is one
+}
+
+// New creates a Diagnostic with a formatted message
+func New(sev Severity, line, col int, code, format string, args ...interface{}) Diagnostic {
+	return Diagnostic{
+		Severity: sev,
+		Line:     line,
+		Column:   col,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Errorf creates an error Diagnostic
+func Errorf(line, col int, code, format string, args ...interface{}) Diagnostic {
+	return New(Error, line, col, code, format, args...)
+}
+
+// Warningf creates a warning Diagnostic
+func Warningf(line, col int, code, format string, args ...interface{}) Diagnostic {
+	return New(Warning, line, col, code, format, args...)
+}
+
+// WithFix returns a copy of d with a suggested fix
+func (d Diagnostic) WithFix(format string, args ...interface{}) Diagnostic {
+	d.Fix = fmt.Sprintf(format, args...)
+	return d
+}
+
+// String formats d as file:line:column: severity[code]: message
+func (d Diagnostic) String() string {
+	loc := d.File
+	if d.Line > 0 {
+		loc += fmt.Sprintf(":%d", d.Line)
+		if d.Column > 0 {
+			loc += fmt.Sprintf(":%d", d.Column)
+		}
+	}
+	s := fmt.Sprintf("%s: %s[%s]: %s", loc, d.Severity, d.Code, d.Message)
+	if d.Fix != "" {
+		s += " (fix: " + d.Fix + ")"
+	}
+	return s
+}
+
+// Error lets a Diagnostic be used as an error
+func (d Diagnostic) Error() string {
+	return d.String()
+}
+
+// SetFile fills in the file of every diagnostic that does not have one
+func SetFile(diags []Diagnostic, file string) {
+	for i := range diags {
+		if diags[i].File == "" {
+			diags[i].File = file
+		}
+	}
+}
+
+// Sort orders diagnostics by file, line and column
+func Sort(diags []Diagnostic) {
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := diags[i], diags[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// Worst returns the highest severity in diags, or -1 if there are none
+func Worst(diags []Diagnostic) Severity {
+	worst := Severity(-1)
+	for _, d := range diags {
+		if d.Severity > worst {
+			worst = d.Severity
+		}
+	}
+	return worst
+}
+
+// HasErrors reports whether any diagnostic is an error
+func HasErrors(diags []Diagnostic) bool {
+	return Worst(diags) >= Error
+}
+
+// Exit codes for the command line tools; 2 is left for usage errors
+const (
+	ExitOK      = 0 // no problems, or only notes
+	ExitError   = 1 // at least one error diagnostic
+	ExitWarning = 3 // warnings but no errors
+)
+
+// ExitCode returns the process exit status for a run that produced diags, which reflects the
+// worst of them
+func ExitCode(diags []Diagnostic) int {
+	switch Worst(diags) {
+	case Error:
+		return ExitError
+	case Warning:
+		return ExitWarning
+	}
+	return ExitOK
+}
+
+// Limit returns the first max diagnostics, followed by a note of how many were left out.
+// Sort diags first, so the ones kept are the earliest. A max of 0 or less means no limit.
+func Limit(diags []Diagnostic, max int) []Diagnostic {
+	if max <= 0 || len(diags) <= max {
+		return diags
+	}
+	kept := append(diags[:max:max], New(Info, 0, 0, "too-many-diagnostics", "%d more not shown", len(diags)-max))
+	kept[max].File = diags[max-1].File
+	return kept
+}
+
+// Output formats accepted by Write (the --format flag of the tools)
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// ValidFormat reports whether Write understands the format
+func ValidFormat(format string) bool {
+	return format == FormatText || format == FormatJSON
+}
+
+// Write prints diags to w, one per line as text, or as a JSON array
+func Write(w io.Writer, format string, diags []Diagnostic) error {
+	if format == FormatJSON {
+		if diags == nil {
+			diags = []Diagnostic{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diags)
+	}
+	for _, d := range diags {
+		if _, err := fmt.Fprintln(w, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
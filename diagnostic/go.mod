@@ -0,0 +1,3 @@
+module diagnostic
+
+go 1.20
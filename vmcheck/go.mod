@@ -0,0 +1,7 @@
+module vmcheck
+
+go 1.20
+
+require diagnostic v0.0.0
+
+replace diagnostic => ../diagnostic
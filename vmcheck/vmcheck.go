@@ -0,0 +1,178 @@
+// Package vmcheck is what the 07 and 08 VM translators share: it checks the commands of .vm
+// files, reports the problems as diagnostics, and writes the output only for a clean run.
+package vmcheck
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"diagnostic"
+)
+
+// Command is one command of a .vm file, with where it is for diagnostics
+type Command struct {
+	File string
+	Line int
+	Text string // the command without its comment
+}
+
+var segments = map[string]bool{
+	"argument": true,
+	"local":    true,
+	"static":   true,
+	"constant": true,
+	"this":     true,
+	"that":     true,
+	"pointer":  true,
+	"temp":     true,
+}
+
+// Check returns the problems that keep c from being translated. commands are the commands
+// the translator knows, with the number of arguments each takes.
+func Check(c Command, commands map[string]int) []diagnostic.Diagnostic {
+	d, ok := c.check(commands)
+	if ok {
+		return nil
+	}
+	d.File = c.File
+	return []diagnostic.Diagnostic{d}
+}
+
+func (c Command) check(commands map[string]int) (diagnostic.Diagnostic, bool) {
+	args := strings.Fields(c.Text)
+	cmd := args[0]
+	n, ok := commands[cmd]
+	if !ok {
+		d := diagnostic.Errorf(c.Line, c.Col(0), "unknown-command", "unknown command %q", cmd)
+		for known := range commands {
+			if strings.EqualFold(cmd, known) {
+				d = d.WithFix("did you mean %s?", known)
+			}
+		}
+		return d, false
+	}
+	if len(args)-1 != n {
+		return diagnostic.Errorf(c.Line, c.Col(0), "wrong-arguments", "%s takes %d arguments, found %d", cmd, n, len(args)-1), false
+	}
+	switch cmd {
+	case "push", "pop":
+		return c.checkSegment(cmd, args[1], args[2])
+	case "label", "goto", "if-goto", "function", "call":
+		if !validSymbol(args[1]) {
+			return diagnostic.Errorf(c.Line, c.Col(1), "invalid-name", "invalid name %q", args[1]).
+				WithFix("names may only use letters, digits, _ . and :, and may not start with a digit"), false
+		}
+	}
+	if cmd == "function" || cmd == "call" {
+		if n, err := strconv.Atoi(args[2]); err != nil || n < 0 {
+			return diagnostic.Errorf(c.Line, c.Col(2), "invalid-count", "%q is not a non-negative number", args[2]), false
+		}
+	}
+	return diagnostic.Diagnostic{}, true
+}
+
+// validSymbol reports whether s is a sequence of letters, digits, underscore, dot and colon that does not begin with a digit
+func validSymbol(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == '.', r == ':':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// checkSegment validates the segment and index of a push or pop command
+func (c Command) checkSegment(cmd, segment, indexString string) (diagnostic.Diagnostic, bool) {
+	if !segments[segment] {
+		return diagnostic.Errorf(c.Line, c.Col(1), "unknown-segment", "unknown segment %q", segment).
+			WithFix("use argument, local, static, constant, this, that, pointer or temp"), false
+	}
+	index, err := strconv.Atoi(indexString)
+	if err != nil || index < 0 {
+		return diagnostic.Errorf(c.Line, c.Col(2), "invalid-index", "index %q is not a non-negative number", indexString), false
+	}
+	switch {
+	case cmd == "pop" && segment == "constant":
+		return diagnostic.Errorf(c.Line, c.Col(1), "pop-constant", "cannot pop to the constant segment").
+			WithFix("pop to temp 0 to discard the value"), false
+	case segment == "pointer" && index > 1:
+		return diagnostic.Errorf(c.Line, c.Col(2), "index-out-of-range", "pointer index %d is out of range", index).
+			WithFix("use pointer 0 (THIS) or pointer 1 (THAT)"), false
+	case segment == "temp" && index > 7:
+		return diagnostic.Errorf(c.Line, c.Col(2), "index-out-of-range", "temp index %d is out of range", index).
+			WithFix("temp holds 8 values, temp 0 to temp 7"), false
+	case segment == "constant" && index > 32767:
+		return diagnostic.Errorf(c.Line, c.Col(2), "constant-too-large", "constant %d is larger than 32767", index), false
+	}
+	return diagnostic.Diagnostic{}, true
+}
+
+// Col returns the column of the i-th field of the command, starting at 1
+func (c Command) Col(i int) int {
+	inField := false
+	for j, r := range c.Text {
+		switch {
+		case unicode.IsSpace(r):
+			inField = false
+		case !inField:
+			inField = true
+			if i == 0 {
+				return j + 1
+			}
+			i--
+		}
+	}
+	return 0
+}
+
+// Report prints the diagnostics (text to stderr, JSON to stdout) and returns the exit status
+func Report(format string, diags []diagnostic.Diagnostic) int {
+	out := os.Stderr
+	if format == diagnostic.FormatJSON {
+		out = os.Stdout
+	}
+	if err := diagnostic.Write(out, format, diags); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return diagnostic.ExitCode(diags)
+}
+
+// Output is a translator's output file, written under a temporary name next to where it
+// goes, so a failed run leaves any earlier output as it was and no partial one
+type Output struct {
+	*os.File
+	name string
+}
+
+// CreateOutput creates the output for the file name
+func CreateOutput(name string) (*Output, error) {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return nil, err
+	}
+	return &Output{File: f, name: name}, nil
+}
+
+// Commit puts the complete output in place
+func (o *Output) Commit() error {
+	if err := o.Chmod(0644); err != nil {
+		return err
+	}
+	if err := o.Close(); err != nil {
+		return err
+	}
+	return os.Rename(o.Name(), o.name)
+}
+
+// Discard removes the output unless it was committed
+func (o *Output) Discard() {
+	o.Close()
+	os.Remove(o.Name())
+}